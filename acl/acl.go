@@ -1,44 +1,70 @@
 package acl
 
-import "github.com/mikespook/gorbac"
+import (
+	"sync"
+
+	"github.com/mikespook/gorbac"
+)
 
 // ACL variables
 var (
-	roleMerchant = gorbac.NewStdRole("merchant")
-	roleCustomer = gorbac.NewStdRole("customer")
-
 	PermissionCatalogWriteAny = gorbac.NewStdPermission("catalog:write:any")
 	PermissionCatalogWriteOwn = gorbac.NewStdPermission("catalog:write:own")
 	PermissionCatalogReadAny  = gorbac.NewStdPermission("catalog:read:any")
 	PermissionCatalogReadOwn  = gorbac.NewStdPermission("catalog:read:own")
+	PermissionCatalogModerate = gorbac.NewStdPermission("catalog:moderate")
+	PermissionCatalogQuote    = gorbac.NewStdPermission("catalog:quote")
+	PermissionCatalogStock    = gorbac.NewStdPermission("catalog:stock")
 )
 
+// permissions provides the permissions which can be granted through the policy
+var permissions = map[string]gorbac.Permission{
+	PermissionCatalogWriteAny.ID(): PermissionCatalogWriteAny,
+	PermissionCatalogWriteOwn.ID(): PermissionCatalogWriteOwn,
+	PermissionCatalogReadAny.ID():  PermissionCatalogReadAny,
+	PermissionCatalogReadOwn.ID():  PermissionCatalogReadOwn,
+	PermissionCatalogModerate.ID(): PermissionCatalogModerate,
+	PermissionCatalogQuote.ID():    PermissionCatalogQuote,
+	PermissionCatalogStock.ID():    PermissionCatalogStock,
+}
+
 // RBAC provides interface Role bases access control list
 type RBAC interface {
 	Can(role string, permission gorbac.Permission) bool
+	Reload() error
 }
 
 type rbac struct {
-	rbac *gorbac.RBAC
+	mutex      sync.RWMutex
+	rbac       *gorbac.RBAC
+	policyFile string
 }
 
-// New returns the role based access control list
-func New() RBAC {
-	rbacObj := gorbac.New()
-
-	// merchant permissions
-	roleMerchant.Assign(PermissionCatalogWriteOwn)
-	roleMerchant.Assign(PermissionCatalogReadOwn)
-
-	// customer permissions
-	roleCustomer.Assign(PermissionCatalogReadAny)
-
-	rbacObj.Add(roleMerchant)
-	rbacObj.Add(roleCustomer)
-	return &rbac{rbac: rbacObj}
+// New loads the policy file and returns the role based access control list
+func New(policyFile string) (RBAC, error) {
+	rbacObj, loadError := loadPolicy(policyFile)
+	if loadError != nil {
+		return nil, loadError
+	}
+	return &rbac{rbac: rbacObj, policyFile: policyFile}, nil
 }
 
 // Can checks if the role has the Permission
 func (rbac *rbac) Can(role string, permission gorbac.Permission) bool {
+	rbac.mutex.RLock()
+	defer rbac.mutex.RUnlock()
 	return rbac.rbac.IsGranted(role, permission, nil)
 }
+
+// Reload loads the policy file again, previous policy is kept if the policy is invalid
+func (rbac *rbac) Reload() error {
+	rbacObj, loadError := loadPolicy(rbac.policyFile)
+	if loadError != nil {
+		return loadError
+	}
+
+	rbac.mutex.Lock()
+	defer rbac.mutex.Unlock()
+	rbac.rbac = rbacObj
+	return nil
+}
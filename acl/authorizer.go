@@ -0,0 +1,131 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/logger"
+	"github.com/mikespook/gorbac"
+)
+
+// Action provides the type of operation performed on the catalog resource
+type Action string
+
+// Catalog actions
+const (
+	ActionRead        Action = "read"
+	ActionWrite       Action = "write"
+	ActionUpdateStock Action = "update_stock"
+	ActionManage      Action = "manage"
+	ActionModerate    Action = "moderate"
+	ActionQuote       Action = "quote"
+	ActionAdjustStock Action = "adjust_stock"
+)
+
+// actionPermissions provides the permissions to perform the action on own resource
+// and on resource of every user. Moderation and quotes are not allowed on own resource
+var actionPermissions = map[Action]struct {
+	own gorbac.Permission
+	any gorbac.Permission
+}{
+	ActionRead:        {own: PermissionCatalogReadOwn, any: PermissionCatalogReadAny},
+	ActionWrite:       {own: PermissionCatalogWriteOwn, any: PermissionCatalogWriteAny},
+	ActionUpdateStock: {own: PermissionCatalogWriteOwn, any: PermissionCatalogWriteAny},
+	ActionManage:      {own: PermissionCatalogWriteOwn, any: PermissionCatalogWriteAny},
+	ActionModerate:    {any: PermissionCatalogModerate},
+	ActionQuote:       {any: PermissionCatalogQuote},
+	ActionAdjustStock: {own: PermissionCatalogWriteOwn, any: PermissionCatalogStock},
+}
+
+// Reason provides the reason of the authorization decision
+type Reason string
+
+// Authorization decision reasons
+const (
+	ReasonAnyPermission Reason = "role is allowed to access resources of every user"
+	ReasonOwner         Reason = "user owns the resource"
+	ReasonDelegate      Reason = "action is delegated to the user by the owner"
+	ReasonNotOwner      Reason = "user does not own the resource"
+	ReasonNoPermission  Reason = "role is not allowed to perform the action"
+)
+
+// Resource provides the ownership details of the catalog resource being accessed
+type Resource struct {
+	OwnerID string
+	// Delegates provides the actions delegated by the owner to other users
+	Delegates map[string][]Action
+}
+
+// Decision provides the result of the authorization
+type Decision struct {
+	Allowed bool
+	Reason  Reason
+}
+
+// Authorizer provides interface to authorize actions on the catalog resources
+type Authorizer interface {
+	Authorize(ctx context.Context, auth authentication.Auth, action Action, resource Resource) Decision
+	Can(auth authentication.Auth, action Action, resource Resource) Decision
+}
+
+type authorizer struct {
+	rbac   RBAC
+	logger *logger.Logger
+}
+
+// NewAuthorizer creates and return the authorizer
+func NewAuthorizer(rbac RBAC, logger *logger.Logger) Authorizer {
+	return &authorizer{
+		rbac:   rbac,
+		logger: logger,
+	}
+}
+
+// Authorize checks if the user is allowed to perform the action on the resource
+// and logs the denied actions
+func (authorizer *authorizer) Authorize(ctx context.Context, auth authentication.Auth, action Action,
+	resource Resource) Decision {
+
+	decision := authorizer.decide(auth, action, resource)
+	if !decision.Allowed {
+		authorizer.logger.WithContext(ctx).Warn(fmt.Sprintf(
+			"Denied '%s' action to user '%s' with role '%s' on resource owned by '%s': %s",
+			action, auth.GetUserID(), auth.GetUserRole(), resource.OwnerID, decision.Reason))
+	}
+	return decision
+}
+
+// Can checks if the user is allowed to perform the action on the resource without logging
+// the denied action. It is used to probe what the user can see, not to enforce the access
+func (authorizer *authorizer) Can(auth authentication.Auth, action Action, resource Resource) Decision {
+	return authorizer.decide(auth, action, resource)
+}
+
+func (authorizer *authorizer) decide(auth authentication.Auth, action Action, resource Resource) Decision {
+	permissions, ok := actionPermissions[action]
+	if !ok {
+		return Decision{Allowed: false, Reason: ReasonNoPermission}
+	}
+
+	role := auth.GetUserRole()
+	if authorizer.rbac.Can(role, permissions.any) {
+		return Decision{Allowed: true, Reason: ReasonAnyPermission}
+	}
+
+	if permissions.own == nil || !authorizer.rbac.Can(role, permissions.own) {
+		return Decision{Allowed: false, Reason: ReasonNoPermission}
+	}
+
+	userID := auth.GetUserID()
+	if resource.OwnerID != "" && userID == resource.OwnerID {
+		return Decision{Allowed: true, Reason: ReasonOwner}
+	}
+
+	for _, delegatedAction := range resource.Delegates[userID] {
+		if delegatedAction == action {
+			return Decision{Allowed: true, Reason: ReasonDelegate}
+		}
+	}
+	return Decision{Allowed: false, Reason: ReasonNotOwner}
+}
@@ -1,10 +1,13 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/dhyaniarun1993/foody-catalog-service/acl (interfaces: RBAC)
+// Source: github.com/dhyaniarun1993/foody-catalog-service/acl (interfaces: RBAC,Authorizer)
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
+	context "context"
+	acl "github.com/dhyaniarun1993/foody-catalog-service/acl"
+	authentication "github.com/dhyaniarun1993/foody-common/authentication"
 	gomock "github.com/golang/mock/gomock"
 	gorbac "github.com/mikespook/gorbac"
 	reflect "reflect"
@@ -46,3 +49,68 @@ func (mr *MockRBACMockRecorder) Can(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Can", reflect.TypeOf((*MockRBAC)(nil).Can), arg0, arg1)
 }
+
+// Reload mocks base method
+func (m *MockRBAC) Reload() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reload")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reload indicates an expected call of Reload
+func (mr *MockRBACMockRecorder) Reload() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reload", reflect.TypeOf((*MockRBAC)(nil).Reload))
+}
+
+// MockAuthorizer is a mock of Authorizer interface
+type MockAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizerMockRecorder
+}
+
+// MockAuthorizerMockRecorder is the mock recorder for MockAuthorizer
+type MockAuthorizerMockRecorder struct {
+	mock *MockAuthorizer
+}
+
+// NewMockAuthorizer creates a new mock instance
+func NewMockAuthorizer(ctrl *gomock.Controller) *MockAuthorizer {
+	mock := &MockAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockAuthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAuthorizer) EXPECT() *MockAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method
+func (m *MockAuthorizer) Authorize(arg0 context.Context, arg1 authentication.Auth, arg2 acl.Action, arg3 acl.Resource) acl.Decision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(acl.Decision)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize
+func (mr *MockAuthorizerMockRecorder) Authorize(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthorizer)(nil).Authorize), arg0, arg1, arg2, arg3)
+}
+
+// Can mocks base method
+func (m *MockAuthorizer) Can(arg0 authentication.Auth, arg1 acl.Action, arg2 acl.Resource) acl.Decision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Can", arg0, arg1, arg2)
+	ret0, _ := ret[0].(acl.Decision)
+	return ret0
+}
+
+// Can indicates an expected call of Can
+func (mr *MockAuthorizerMockRecorder) Can(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Can", reflect.TypeOf((*MockAuthorizer)(nil).Can), arg0, arg1, arg2)
+}
@@ -0,0 +1,114 @@
+package acl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/mikespook/gorbac"
+)
+
+// Policy provides the schema definition for the access control policy file
+type Policy struct {
+	Roles map[string]RolePolicy `json:"roles"`
+}
+
+// RolePolicy provides the schema definition for permissions and parents of a role.
+// Role inherits all the permissions of its parents
+type RolePolicy struct {
+	Permissions []string `json:"permissions"`
+	Parents     []string `json:"parents"`
+}
+
+// Validate validates Policy schema
+func (policy Policy) Validate() error {
+	if len(policy.Roles) == 0 {
+		return fmt.Errorf("policy should define at least one role")
+	}
+
+	for roleID, role := range policy.Roles {
+		if roleID == "" {
+			return fmt.Errorf("role id cannot be empty")
+		}
+		for _, permissionID := range role.Permissions {
+			if _, ok := permissions[permissionID]; !ok {
+				return fmt.Errorf("unknown permission '%s' for role '%s'", permissionID, roleID)
+			}
+		}
+		for _, parentID := range role.Parents {
+			if _, ok := policy.Roles[parentID]; !ok {
+				return fmt.Errorf("unknown parent '%s' for role '%s'", parentID, roleID)
+			}
+		}
+	}
+
+	// role inheritance should not have a cycle
+	visited := map[string]int{}
+	for roleID := range policy.Roles {
+		cycleError := policy.checkCycle(roleID, visited)
+		if cycleError != nil {
+			return cycleError
+		}
+	}
+	return nil
+}
+
+// checkCycle walks the parents of the role depth first, visited holds 1 for the roles
+// on the current path and 2 for the roles which are already checked
+func (policy Policy) checkCycle(roleID string, visited map[string]int) error {
+	switch visited[roleID] {
+	case 1:
+		return fmt.Errorf("role '%s' inherits from itself", roleID)
+	case 2:
+		return nil
+	}
+
+	visited[roleID] = 1
+	for _, parentID := range policy.Roles[roleID].Parents {
+		cycleError := policy.checkCycle(parentID, visited)
+		if cycleError != nil {
+			return cycleError
+		}
+	}
+	visited[roleID] = 2
+	return nil
+}
+
+// loadPolicy reads, validates and converts the policy file to gorbac RBAC
+func loadPolicy(policyFile string) (*gorbac.RBAC, error) {
+	var policy Policy
+	policyData, readError := ioutil.ReadFile(policyFile)
+	if readError != nil {
+		return nil, fmt.Errorf("unable to read policy file: %v", readError)
+	}
+
+	unmarshalError := json.Unmarshal(policyData, &policy)
+	if unmarshalError != nil {
+		return nil, fmt.Errorf("unable to parse policy file: %v", unmarshalError)
+	}
+
+	validationError := policy.Validate()
+	if validationError != nil {
+		return nil, fmt.Errorf("invalid policy: %v", validationError)
+	}
+
+	rbacObj := gorbac.New()
+	for roleID, rolePolicy := range policy.Roles {
+		role := gorbac.NewStdRole(roleID)
+		for _, permissionID := range rolePolicy.Permissions {
+			role.Assign(permissions[permissionID])
+		}
+		rbacObj.Add(role)
+	}
+
+	for roleID, rolePolicy := range policy.Roles {
+		if len(rolePolicy.Parents) == 0 {
+			continue
+		}
+		parentError := rbacObj.SetParents(roleID, rolePolicy.Parents)
+		if parentError != nil {
+			return nil, fmt.Errorf("invalid policy: %v", parentError)
+		}
+	}
+	return rbacObj, nil
+}
@@ -10,15 +10,19 @@ import (
 	"gopkg.in/go-playground/validator.v9"
 )
 
-// Category provides the model definition for Product Category
+// Category provides the model definition for Product Category. Position provides
+// the display order of the category in the restaurant menu and is managed by the service
 type Category struct {
-	ID           string            `bson:"_id,omitempty" json:"id"`
-	RestaurantID string            `bson:"restaurant_id,omitempty" json:"restaurant_id" validate:"required"`
-	Name         string            `bson:"name" json:"name" validate:"required,min=2,max=30"`
-	Description  string            `bson:"description" json:"description" validate:"max=120"`
-	Products     []product.Product `bson:"products" json:"products,omitempty"`
-	CreatedAt    time.Time         `bson:"created_at" json:"created_at"`
-	UpdatedAt    time.Time         `bson:"updated_at" json:"updated_at"`
+	ID               string            `bson:"_id,omitempty" json:"id"`
+	RestaurantID     string            `bson:"restaurant_id,omitempty" json:"restaurant_id" validate:"required"`
+	ParentCategoryID string            `bson:"parent_category_id,omitempty" json:"parent_category_id,omitempty"`
+	Name             string            `bson:"name" json:"name" validate:"required,min=2,max=30"`
+	Description      string            `bson:"description" json:"description" validate:"max=120"`
+	Position         int64             `bson:"position" json:"position"`
+	Products         []product.Product `bson:"products" json:"products,omitempty"`
+	Subcategories    []Category        `bson:"-" json:"subcategories,omitempty"`
+	CreatedAt        time.Time         `bson:"created_at" json:"created_at"`
+	UpdatedAt        time.Time         `bson:"updated_at" json:"updated_at"`
 }
 
 // Validate validates Category schema
@@ -35,3 +39,34 @@ func (category *Category) Validate(validate *validator.Validate) errors.AppError
 	}
 	return nil
 }
+
+// BuildTree arranges the categories under their parent category. Categories
+// without parent are returned at the top level and order of the siblings is preserved
+func BuildTree(categories []Category) []Category {
+	exists := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		exists[category.ID] = true
+	}
+
+	tree := []Category{}
+	children := make(map[string][]Category)
+	for _, category := range categories {
+		if category.ParentCategoryID == "" || !exists[category.ParentCategoryID] {
+			tree = append(tree, category)
+			continue
+		}
+		children[category.ParentCategoryID] = append(children[category.ParentCategoryID], category)
+	}
+
+	for i := range tree {
+		tree[i] = attachSubcategories(tree[i], children)
+	}
+	return tree
+}
+
+func attachSubcategories(category Category, children map[string][]Category) Category {
+	for _, child := range children[category.ID] {
+		category.Subcategories = append(category.Subcategories, attachSubcategories(child, children))
+	}
+	return category
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
 	"github.com/dhyaniarun1993/foody-catalog-service/category"
 
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
 	"github.com/dhyaniarun1993/foody-common/authentication"
 	"github.com/dhyaniarun1993/foody-common/errors"
 )
@@ -26,9 +27,21 @@ func (interactor *categoryInteractor) Create(ctx context.Context, auth authentic
 		return category.Category{}, getRestaurantError
 	}
 
-	if (restaurant.MerchantID == auth.GetUserID() &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteOwn)) ||
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteAny) {
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+
+		if categoryObj.ParentCategoryID != "" {
+			categories, getCategoriesError := interactor.categoryRepository.GetByRestaurantID(ctx,
+				categoryObj.RestaurantID)
+			if getCategoriesError != nil {
+				return category.Category{}, getCategoriesError
+			}
+
+			parentError := interactor.validateParent(categories, "", categoryObj.ParentCategoryID)
+			if parentError != nil {
+				return category.Category{}, parentError
+			}
+		}
 
 		var createCategoryError errors.AppError
 		categoryObj, createCategoryError := interactor.categoryRepository.Create(ctx, categoryObj)
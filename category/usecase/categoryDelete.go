@@ -8,12 +8,13 @@ import (
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
 	"github.com/dhyaniarun1993/foody-catalog-service/category"
 
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
 	"github.com/dhyaniarun1993/foody-common/authentication"
 	"github.com/dhyaniarun1993/foody-common/errors"
 )
 
 func (interactor *categoryInteractor) DeleteByID(ctx context.Context, auth authentication.Auth,
-	categoryID string) errors.AppError {
+	categoryID string, cascade bool) errors.AppError {
 
 	categoryObj, getCategoryError := interactor.categoryRepository.GetByID(ctx, categoryID)
 	if getCategoryError != nil {
@@ -33,19 +34,52 @@ func (interactor *categoryInteractor) DeleteByID(ctx context.Context, auth authe
 	}
 
 	// check if user have permission to delete category
-	if restaurant.MerchantID == auth.GetUserID() &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteOwn) ||
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteAny) {
-
-		// delete products of the provided category
-		deleteProductError := interactor.productRepository.DeleteProductByCategoryID(ctx, categoryID)
-		if deleteProductError != nil {
-			return deleteProductError
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+
+		categories, getCategoriesError := interactor.categoryRepository.GetByRestaurantID(ctx,
+			categoryObj.RestaurantID)
+		if getCategoriesError != nil {
+			return getCategoriesError
+		}
+
+		// sub categories are only deleted when asked explicitly
+		descendants := getDescendants(categoryID, getChildren(categories))
+		if len(descendants) > 0 && !cascade {
+			return errors.NewAppError("Category has sub categories, delete them first or use cascade",
+				http.StatusConflict, nil)
 		}
 
-		// finally delete the category
-		deleteCategoryError := interactor.categoryRepository.DeleteByID(ctx, categoryID)
-		return deleteCategoryError
+		// category is deleted along with its sub categories and products or not at all,
+		// sub categories are deleted before their parent
+		categoryIDs := append(descendants, categoryID)
+		return interactor.unitOfWork.Do(ctx, func(ctx context.Context) errors.AppError {
+			// products which are components of combos can't be deleted
+			comboCount, countError := interactor.productRepository.CountCombosByComponentCategoryIDs(ctx,
+				categoryIDs)
+			if countError != nil {
+				return countError
+			}
+			if comboCount > 0 {
+				return errors.NewAppError("Products of the category are part of combos, remove them from combos first",
+					http.StatusConflict, nil)
+			}
+
+			for _, id := range categoryIDs {
+				// delete products of the category
+				deleteProductError := interactor.productRepository.DeleteProductByCategoryID(ctx, id)
+				if deleteProductError != nil {
+					return deleteProductError
+				}
+
+				// finally delete the category
+				deleteCategoryError := interactor.categoryRepository.DeleteByID(ctx, id)
+				if deleteCategoryError != nil {
+					return deleteCategoryError
+				}
+			}
+			return nil
+		})
 	}
 	return errors.NewAppError("Forbidden", http.StatusForbidden, nil)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
 	"github.com/dhyaniarun1993/foody-catalog-service/category"
 
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
 	"github.com/dhyaniarun1993/foody-common/authentication"
 	"github.com/dhyaniarun1993/foody-common/errors"
 )
@@ -33,9 +34,8 @@ func (interactor *categoryInteractor) GetByID(ctx context.Context, auth authenti
 	}
 
 	// check if user have permission to get category
-	if (restaurant.MerchantID == auth.GetUserID() &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogReadOwn)) ||
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogReadAny) {
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionRead,
+		restaurantUsecase.Resource(restaurant)).Allowed {
 
 		return categoryObj, nil
 	}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/category"
+
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *categoryInteractor) GetByRestaurantID(ctx context.Context, auth authentication.Auth,
+	restaurantID string) ([]category.Category, errors.AppError) {
+
+	// user should have permission to get the restaurant
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx, auth, restaurantID)
+	if getRestaurantError != nil {
+		return nil, getRestaurantError
+	}
+
+	// check if user have permission to get categories
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionRead,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+
+		categories, repositoryError := interactor.categoryRepository.GetByRestaurantID(ctx, restaurantID)
+		if repositoryError != nil {
+			return nil, repositoryError
+		}
+		return category.BuildTree(categories), nil
+	}
+	return nil, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
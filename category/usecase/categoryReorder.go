@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/category"
+	"gopkg.in/go-playground/validator.v9"
+
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *categoryInteractor) Reorder(ctx context.Context, auth authentication.Auth,
+	restaurantID string, request ReorderRequest) ([]category.Category, errors.AppError) {
+
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return nil, validationError
+	}
+
+	// user should have permission to get the restaurant
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx, auth, restaurantID)
+	if getRestaurantError != nil {
+		return nil, getRestaurantError
+	}
+
+	// check if user have permission to reorder categories
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+
+		categories, getCategoriesError := interactor.categoryRepository.GetByRestaurantID(ctx, restaurantID)
+		if getCategoriesError != nil {
+			return nil, getCategoriesError
+		}
+
+		// order should contain every category of the restaurant exactly once
+		categoryByID := make(map[string]category.Category, len(categories))
+		for _, categoryObj := range categories {
+			categoryByID[categoryObj.ID] = categoryObj
+		}
+		if len(request.CategoryIDs) != len(categories) {
+			return nil, errors.NewAppError("Order should contain all the categories of the restaurant",
+				http.StatusBadRequest, nil)
+		}
+
+		orderedCategories := make([]category.Category, len(request.CategoryIDs))
+		for i, categoryID := range request.CategoryIDs {
+			categoryObj, found := categoryByID[categoryID]
+			if !found {
+				return nil, errors.NewAppError(
+					fmt.Sprintf("Category '%s' is either repeated or doesnot belong to the restaurant", categoryID),
+					http.StatusBadRequest, nil)
+			}
+			delete(categoryByID, categoryID)
+			categoryObj.Position = int64(i)
+			orderedCategories[i] = categoryObj
+		}
+
+		reorderError := interactor.categoryRepository.Reorder(ctx, restaurantID, request.CategoryIDs)
+		if reorderError != nil {
+			return nil, reorderError
+		}
+		return orderedCategories, nil
+	}
+	return nil, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
+
+// ReorderRequest provides the schema definition for category reorder request
+type ReorderRequest struct {
+	CategoryIDs []string `json:"category_ids" validate:"required,min=1"`
+}
+
+// Validate validates ReorderRequest
+func (request ReorderRequest) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("validation for field '%s' failed on '%s'", err.Field(), err.Tag())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
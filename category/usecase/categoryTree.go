@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/category"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+// validateParent checks if the category can be placed under the parent category
+// of the same restaurant without creating a cycle or exceeding the max depth.
+// Category id is empty for the category which is not created yet
+func (interactor *categoryInteractor) validateParent(categories []category.Category, categoryID string,
+	parentID string) errors.AppError {
+
+	parentByID := make(map[string]string, len(categories))
+	for _, categoryObj := range categories {
+		parentByID[categoryObj.ID] = categoryObj.ParentCategoryID
+	}
+
+	if _, found := parentByID[parentID]; !found {
+		return errors.NewAppError("Parent category doesnot belong to the restaurant", http.StatusBadRequest, nil)
+	}
+
+	// depth of the parent category, top level category is at depth 1
+	parentDepth := 0
+	ancestorID := parentID
+	for ancestorID != "" && parentDepth <= len(categories) {
+		if ancestorID == categoryID {
+			return errors.NewAppError("Category cannot be placed under itself or its sub categories",
+				http.StatusBadRequest, nil)
+		}
+		parentDepth++
+		ancestorID = parentByID[ancestorID]
+	}
+
+	// sub categories are moved along with the category
+	height := 1
+	if categoryID != "" {
+		height = getHeight(categoryID, getChildren(categories))
+	}
+
+	if parentDepth+height > interactor.maxCategoryDepth {
+		return errors.NewAppError(
+			fmt.Sprintf("Categories cannot be nested more than %d levels", interactor.maxCategoryDepth),
+			http.StatusBadRequest, nil)
+	}
+	return nil
+}
+
+// getDescendants returns the ids of sub categories of the category, deepest sub categories first
+func getDescendants(categoryID string, children map[string][]string) []string {
+	descendants := []string{}
+	for _, childID := range children[categoryID] {
+		descendants = append(descendants, getDescendants(childID, children)...)
+		descendants = append(descendants, childID)
+	}
+	return descendants
+}
+
+func getHeight(categoryID string, children map[string][]string) int {
+	maxChildHeight := 0
+	for _, childID := range children[categoryID] {
+		childHeight := getHeight(childID, children)
+		if childHeight > maxChildHeight {
+			maxChildHeight = childHeight
+		}
+	}
+	return maxChildHeight + 1
+}
+
+// getChildren returns the ids of the direct sub categories by the parent category id
+func getChildren(categories []category.Category) map[string][]string {
+	children := make(map[string][]string)
+	for _, categoryObj := range categories {
+		if categoryObj.ParentCategoryID != "" {
+			children[categoryObj.ParentCategoryID] = append(children[categoryObj.ParentCategoryID], categoryObj.ID)
+		}
+	}
+	return children
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/category"
+	"github.com/dhyaniarun1993/foody-catalog-service/mergepatch"
+
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *categoryInteractor) Update(ctx context.Context, auth authentication.Auth,
+	categoryID string, patch json.RawMessage) (category.Category, errors.AppError) {
+
+	// user should have permission to get the category
+	categoryObj, getCategoryError := interactor.GetByID(ctx, auth, categoryID)
+	if getCategoryError != nil {
+		return category.Category{}, getCategoryError
+	}
+
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx, auth,
+		categoryObj.RestaurantID)
+	if getRestaurantError != nil {
+		return category.Category{}, getRestaurantError
+	}
+
+	// check if user have permission to update category
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+
+		// sub categories are not part of the category document
+		categoryObj.Subcategories = nil
+		updatedCategory := categoryObj
+		patchError := mergepatch.Apply(&updatedCategory, patch)
+		if patchError != nil {
+			return category.Category{}, patchError
+		}
+
+		// fields managed by the service cannot be patched
+		updatedCategory.ID = categoryObj.ID
+		updatedCategory.RestaurantID = categoryObj.RestaurantID
+		updatedCategory.Position = categoryObj.Position
+		updatedCategory.Products = nil
+		updatedCategory.Subcategories = nil
+		updatedCategory.CreatedAt = categoryObj.CreatedAt
+
+		validationError := updatedCategory.Validate(interactor.validator)
+		if validationError != nil {
+			return category.Category{}, validationError
+		}
+
+		// category is moved under another parent category
+		if updatedCategory.ParentCategoryID != "" &&
+			updatedCategory.ParentCategoryID != categoryObj.ParentCategoryID {
+
+			categories, getCategoriesError := interactor.categoryRepository.GetByRestaurantID(ctx,
+				categoryObj.RestaurantID)
+			if getCategoriesError != nil {
+				return category.Category{}, getCategoriesError
+			}
+
+			parentError := interactor.validateParent(categories, categoryObj.ID, updatedCategory.ParentCategoryID)
+			if parentError != nil {
+				return category.Category{}, parentError
+			}
+		}
+
+		var repositoryError errors.AppError
+		updatedCategory, repositoryError = interactor.categoryRepository.Update(ctx, updatedCategory)
+		return updatedCategory, repositoryError
+	}
+	return category.Category{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
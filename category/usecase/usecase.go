@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
 	"github.com/dhyaniarun1993/foody-catalog-service/category"
@@ -13,14 +14,22 @@ import (
 	"github.com/dhyaniarun1993/foody-common/errors"
 )
 
+type unitOfWork interface {
+	Do(ctx context.Context, work func(ctx context.Context) errors.AppError) errors.AppError
+}
+
 type categoryRepository interface {
 	Create(ctx context.Context, category category.Category) (category.Category, errors.AppError)
 	GetByID(ctx context.Context, categoryID string) (category.Category, errors.AppError)
+	Update(ctx context.Context, category category.Category) (category.Category, errors.AppError)
+	GetByRestaurantID(ctx context.Context, restaurantID string) ([]category.Category, errors.AppError)
+	Reorder(ctx context.Context, restaurantID string, categoryIDs []string) errors.AppError
 	DeleteByID(ctx context.Context, categoryID string) errors.AppError
 }
 
 type productRepository interface {
 	DeleteProductByCategoryID(ctx context.Context, categoryID string) errors.AppError
+	CountCombosByComponentCategoryIDs(ctx context.Context, categoryIDs []string) (int64, errors.AppError)
 }
 
 // Interactor provides interface for category interactor
@@ -29,29 +38,39 @@ type Interactor interface {
 		category category.Category) (category.Category, errors.AppError)
 	GetByID(ctx context.Context, auth authentication.Auth,
 		categoryID string) (category.Category, errors.AppError)
-	DeleteByID(ctx context.Context, auth authentication.Auth, categoryID string) errors.AppError
+	Update(ctx context.Context, auth authentication.Auth, categoryID string,
+		patch json.RawMessage) (category.Category, errors.AppError)
+	DeleteByID(ctx context.Context, auth authentication.Auth, categoryID string, cascade bool) errors.AppError
+	GetByRestaurantID(ctx context.Context, auth authentication.Auth,
+		restaurantID string) ([]category.Category, errors.AppError)
+	Reorder(ctx context.Context, auth authentication.Auth, restaurantID string,
+		request ReorderRequest) ([]category.Category, errors.AppError)
 }
 
 type categoryInteractor struct {
 	categoryRepository   categoryRepository
 	productRepository    productRepository
+	unitOfWork           unitOfWork
 	restaurantInteractor restaurantUsecase.Interactor
 	logger               *logger.Logger
 	validator            *validator.Validate
-	rbac                 acl.RBAC
+	authorizer           acl.Authorizer
+	maxCategoryDepth     int
 }
 
 // NewCategoryInteractor creates and return category Interactor
 func NewCategoryInteractor(categoryRepository categoryRepository, productRepository productRepository,
-	restaurantInteractor restaurantUsecase.Interactor, logger *logger.Logger, rbac acl.RBAC,
-	validator *validator.Validate) Interactor {
+	unitOfWork unitOfWork, restaurantInteractor restaurantUsecase.Interactor, logger *logger.Logger,
+	authorizer acl.Authorizer, validator *validator.Validate, maxCategoryDepth int) Interactor {
 
 	return &categoryInteractor{
 		categoryRepository:   categoryRepository,
 		productRepository:    productRepository,
+		unitOfWork:           unitOfWork,
 		restaurantInteractor: restaurantInteractor,
 		logger:               logger,
 		validator:            validator,
-		rbac:                 rbac,
+		authorizer:           authorizer,
+		maxCategoryDepth:     maxCategoryDepth,
 	}
 }
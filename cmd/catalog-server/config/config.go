@@ -2,6 +2,7 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 
@@ -12,10 +13,16 @@ import (
 
 // Configuration provides application configuration
 type Configuration struct {
-	Port   int `required:"true" split_words:"true"`
-	Mongo  mongo.Configuration
-	Log    logger.Configuration
-	Jaeger tracer.Configuration
+	Port                    int           `required:"true" split_words:"true"`
+	MaxSearchRadius         float64       `split_words:"true" default:"10000"`
+	MaxCategoryDepth        int           `split_words:"true" default:"2"`
+	ACLPolicyFile           string        `required:"true" split_words:"true"`
+	StockResetInterval      time.Duration `split_words:"true" default:"1m"`
+	ReservationReapInterval time.Duration `split_words:"true" default:"10s"`
+	ReservationRetention    time.Duration `split_words:"true" default:"24h"`
+	Mongo                   mongo.Configuration
+	Log                     logger.Configuration
+	Jaeger                  tracer.Configuration
 }
 
 // InitConfiguration initialize the configuration
@@ -1,8 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -19,6 +24,7 @@ import (
 	repositories "github.com/dhyaniarun1993/foody-catalog-service/repositories/mongo"
 	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
 	"github.com/dhyaniarun1993/foody-common/datastore/mongo"
+	"github.com/dhyaniarun1993/foody-common/errors"
 	"github.com/dhyaniarun1993/foody-common/logger"
 	"github.com/dhyaniarun1993/foody-common/tracer"
 )
@@ -32,20 +38,68 @@ func main() {
 	mongoClient := mongo.CreateMongoDBPool(config.Mongo, t)
 	validate := validator.New()
 	schemaDecoder := schema.NewDecoder()
-	rbac := acl.New()
+	rbac, aclError := acl.New(config.ACLPolicyFile)
+	if aclError != nil {
+		logger.Error("Unable to load ACL policy: " + aclError.Error())
+		return
+	}
+
+	// reload ACL policy on SIGHUP
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	go func() {
+		for range hangup {
+			reloadError := rbac.Reload()
+			if reloadError != nil {
+				logger.Error("Unable to reload ACL policy, keeping the previous policy: " + reloadError.Error())
+				continue
+			}
+			logger.Info("ACL policy reloaded")
+		}
+	}()
+	authorizer := acl.NewAuthorizer(rbac, logger)
 
 	healthRepository := repositories.NewHealthRepository(mongoClient)
 	restaurantRepository := repositories.NewRestaurantRepository(mongoClient, config.Mongo.Database)
 	categoryRepository := repositories.NewCategoryRepository(mongoClient, config.Mongo.Database)
 	productRepository := repositories.NewProductRepository(mongoClient, config.Mongo.Database)
+	reservationRepository := repositories.NewReservationRepository(mongoClient, config.Mongo.Database)
+	unitOfWork := repositories.NewUnitOfWork(mongoClient)
+
+	indexError := restaurantRepository.CreateIndexes(context.Background())
+	if indexError != nil {
+		logger.Error("Unable to create restaurant indexes: " + indexError.Error())
+		return
+	}
+	indexError = reservationRepository.CreateIndexes(context.Background(), config.ReservationRetention)
+	if indexError != nil {
+		logger.Error("Unable to create reservation indexes: " + indexError.Error())
+		return
+	}
+	migrationError := productRepository.MigrateStockOverride(context.Background())
+	if migrationError != nil {
+		logger.Error("Unable to migrate product stock: " + migrationError.Error())
+		return
+	}
 
 	healthInteractor := health.NewHealthInteractor(healthRepository, logger)
 	restaurantInteractor := restaurantUsecase.NewRestaurantInteractor(restaurantRepository,
-		categoryRepository, productRepository, logger, rbac, validate)
-	categoryInteractor := categoryUsecase.NewCategoryInteractor(categoryRepository,
-		productRepository, restaurantInteractor, logger, rbac, validate)
-	productInteractor := productUsecase.NewProductInteractor(productRepository, restaurantInteractor,
-		categoryInteractor, logger, rbac, validate)
+		categoryRepository, productRepository, unitOfWork, logger, authorizer, validate, config.MaxSearchRadius)
+	categoryInteractor := categoryUsecase.NewCategoryInteractor(categoryRepository, productRepository,
+		unitOfWork, restaurantInteractor, logger, authorizer, validate, config.MaxCategoryDepth)
+	productInteractor := productUsecase.NewProductInteractor(productRepository, reservationRepository,
+		unitOfWork, restaurantInteractor, categoryInteractor, logger, authorizer, validate)
+
+	// background jobs are stopped along with the server
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+
+	// daily quantity of the variants is reset once the day changes in the time zone of their restaurant
+	runPeriodically(workersCtx, &workers, config.StockResetInterval, productInteractor.ResetDailyStock,
+		logger, "reset daily stock")
+	// units of the expired reservations go back to the stock
+	runPeriodically(workersCtx, &workers, config.ReservationReapInterval, productInteractor.ExpireReservations,
+		logger, "expire reservations")
 
 	router := mux.NewRouter()
 	ignoredURLs := []string{"/health"}
@@ -65,7 +119,7 @@ func main() {
 	c := cors.New(cors.Options{
 		AllowedOrigins: []string{"*"},
 		AllowedHeaders: []string{"X-User-Id", "X-User-Role", "X-Client-Id", "Content-Type"},
-		AllowedMethods: []string{"GET", "PUT", "POST", "DELETE", "OPTION"},
+		AllowedMethods: []string{"GET", "PUT", "PATCH", "POST", "DELETE", "OPTION"},
 		// Enable Debugging for testing, consider disabling in production
 		// Debug: true,
 	})
@@ -77,10 +131,53 @@ func main() {
 	}
 
 	logger.Info("Starting Http server at " + serverAddress)
-	serverError := srv.ListenAndServe()
-	if serverError != http.ErrServerClosed {
-		logger.Error("Http server stopped unexpected")
-	} else {
-		logger.Info("Http server stopped")
+	serverErrors := make(chan error, 1)
+	go func() {
+		serverErrors <- srv.ListenAndServe()
+	}()
+
+	// stop accepting requests on SIGINT or SIGTERM and let the in flight requests complete
+	shutdown := make(chan os.Signal, 1)
+	signal.Notify(shutdown, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case serverError := <-serverErrors:
+		logger.Error("Http server stopped unexpected: " + serverError.Error())
+	case <-shutdown:
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		shutdownError := srv.Shutdown(shutdownCtx)
+		shutdownCancel()
+		if shutdownError != nil {
+			logger.Error("Unable to stop Http server gracefully: " + shutdownError.Error())
+		} else {
+			logger.Info("Http server stopped")
+		}
 	}
+
+	stopWorkers()
+	workers.Wait()
+	logger.Info("Background jobs stopped")
+}
+
+// runPeriodically runs the job every interval until the context is cancelled. Job which is
+// running when the context is cancelled is allowed to complete
+func runPeriodically(ctx context.Context, workers *sync.WaitGroup, interval time.Duration,
+	job func(ctx context.Context) errors.AppError, logger *logger.Logger, name string) {
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				jobError := job(context.Background())
+				if jobError != nil {
+					logger.Error("Unable to " + name + ": " + jobError.Error())
+				}
+			}
+		}
+	}()
 }
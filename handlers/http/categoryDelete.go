@@ -3,6 +3,7 @@ package http
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/dhyaniarun1993/foody-common/authentication"
 	"github.com/gorilla/mux"
@@ -16,7 +17,22 @@ func (handler *categoryHandler) deleteByID(w http.ResponseWriter, r *http.Reques
 	params := mux.Vars(r)
 	categoryID := params["categoryId"]
 
-	serviceError := handler.categoryInteractor.DeleteByID(ctx, auth, categoryID)
+	// sub categories are deleted along with the category only if cascade is set
+	cascade := false
+	if cascadeParam := r.URL.Query().Get("cascade"); cascadeParam != "" {
+		var parseError error
+		cascade, parseError = strconv.ParseBool(cascadeParam)
+		if parseError != nil {
+			errorMsg := "Invalid request query Params"
+			logger.WithError(parseError).Error(errorMsg)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"message": %q }`, errorMsg)
+			return
+		}
+	}
+
+	serviceError := handler.categoryInteractor.DeleteByID(ctx, auth, categoryID, cascade)
 	if serviceError != nil {
 		logger.WithError(serviceError).Error("Got error from service")
 		w.Header().Set("Content-Type", "application/json")
@@ -37,7 +37,19 @@ func (handler *categoryHandler) LoadRoutes(router *mux.Router) {
 		middlewares.ChainHandlerFuncMiddlewares(handler.getByID,
 			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("GET")
 
+	router.Handle("/v1/catalog/categories/{categoryId}",
+		middlewares.ChainHandlerFuncMiddlewares(handler.update,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("PATCH")
+
 	router.Handle("/v1/catalog/categories/{categoryId}",
 		middlewares.ChainHandlerFuncMiddlewares(handler.deleteByID,
 			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("DELETE")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/categories",
+		middlewares.ChainHandlerFuncMiddlewares(handler.getByRestaurantID,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("GET")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/categories/order",
+		middlewares.ChainHandlerFuncMiddlewares(handler.reorder,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("PUT")
 }
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	categoryUsecase "github.com/dhyaniarun1993/foody-catalog-service/category/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/gorilla/mux"
+)
+
+func (handler *categoryHandler) reorder(w http.ResponseWriter, r *http.Request) {
+	var request categoryUsecase.ReorderRequest
+	ctx := r.Context()
+	auth, _ := authentication.GetAuthFromContext(ctx)
+	logger := handler.logger.WithContext(ctx)
+
+	params := mux.Vars(r)
+	restaurantID := params["restaurantId"]
+
+	decodingError := json.NewDecoder(r.Body).Decode(&request)
+	if decodingError != nil {
+		errorMsg := "Invalid request"
+		logger.WithError(decodingError).Error(errorMsg)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"message": %q }`, errorMsg)
+		return
+	}
+
+	result, serviceError := handler.categoryInteractor.Reorder(ctx, auth, restaurantID, request)
+	if serviceError != nil {
+		logger.WithError(serviceError).Error("Got Error from service")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(serviceError.StatusCode())
+		fmt.Fprintf(w, `{"message": %q }`, serviceError.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
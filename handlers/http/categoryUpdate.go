@@ -0,0 +1,43 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/gorilla/mux"
+)
+
+func (handler *categoryHandler) update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	auth, _ := authentication.GetAuthFromContext(ctx)
+	logger := handler.logger.WithContext(ctx)
+
+	params := mux.Vars(r)
+	categoryID := params["categoryId"]
+
+	patch, readError := ioutil.ReadAll(r.Body)
+	if readError != nil {
+		errorMsg := "Invalid request"
+		logger.WithError(readError).Error(errorMsg)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"message": %q }`, errorMsg)
+		return
+	}
+
+	result, serviceError := handler.categoryInteractor.Update(ctx, auth, categoryID, patch)
+	if serviceError != nil {
+		logger.WithError(serviceError).Error("Got Error from service")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(serviceError.StatusCode())
+		fmt.Fprintf(w, `{"message": %q }`, serviceError.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/gorilla/mux"
+)
+
+func (handler *restaurantHandler) deleteScheduleException(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	auth, _ := authentication.GetAuthFromContext(ctx)
+	logger := handler.logger.WithContext(ctx)
+	params := mux.Vars(r)
+	restaurantID := params["restaurantId"]
+	exceptionID := params["exceptionId"]
+
+	serviceError := handler.restaurantInteractor.DeleteScheduleException(ctx, auth, restaurantID, exceptionID)
+	if serviceError != nil {
+		logger.WithError(serviceError).Error("Got Error from Service")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(serviceError.StatusCode())
+		fmt.Fprintf(w, `{"message": %q}`, serviceError.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/gorilla/mux"
+)
+
+func (handler *restaurantHandler) updateScheduleException(w http.ResponseWriter, r *http.Request) {
+	var exception restaurant.ScheduleException
+	ctx := r.Context()
+	auth, _ := authentication.GetAuthFromContext(ctx)
+	logger := handler.logger.WithContext(ctx)
+	params := mux.Vars(r)
+	restaurantID := params["restaurantId"]
+	exceptionID := params["exceptionId"]
+
+	decodingError := json.NewDecoder(r.Body).Decode(&exception)
+	if decodingError != nil {
+		errorMsg := "Invalid request"
+		logger.WithError(decodingError).Error(errorMsg)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"message": %q}`, errorMsg)
+		return
+	}
+
+	result, serviceError := handler.restaurantInteractor.UpdateScheduleException(ctx, auth,
+		restaurantID, exceptionID, exception)
+	if serviceError != nil {
+		logger.WithError(serviceError).Error("Got Error from Service")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(serviceError.StatusCode())
+		fmt.Fprintf(w, `{"message": %q}`, serviceError.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
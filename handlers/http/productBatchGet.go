@@ -0,0 +1,39 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	productUsecase "github.com/dhyaniarun1993/foody-catalog-service/product/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+)
+
+func (handler *productHandler) batchGetProducts(w http.ResponseWriter, r *http.Request) {
+	var request productUsecase.BatchGetProductsRequest
+	ctx := r.Context()
+	auth, _ := authentication.GetAuthFromContext(ctx)
+	logger := handler.logger.WithContext(ctx)
+
+	decodeError := json.NewDecoder(r.Body).Decode(&request)
+	if decodeError != nil {
+		logger.WithError(decodeError).Error("Invalid request body")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"message": %q}`, decodeError.Error())
+		return
+	}
+
+	result, serviceError := handler.productInteractor.BatchGetProducts(ctx, auth, request)
+	if serviceError != nil {
+		logger.WithError(serviceError).Error("Got Error from service")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(serviceError.StatusCode())
+		fmt.Fprintf(w, `{"message": %q}`, serviceError.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
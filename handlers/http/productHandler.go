@@ -33,10 +33,22 @@ func (handler *productHandler) LoadRoutes(router *mux.Router) {
 		middlewares.ChainHandlerFuncMiddlewares(handler.createProduct,
 			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
 
+	router.Handle("/v1/catalog/products",
+		middlewares.ChainHandlerFuncMiddlewares(handler.getAllProducts,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("GET")
+
+	router.Handle("/v1/catalog/products:batchGet",
+		middlewares.ChainHandlerFuncMiddlewares(handler.batchGetProducts,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
+
 	router.Handle("/v1/catalog/products/{productId}",
 		middlewares.ChainHandlerFuncMiddlewares(handler.getProductByID,
 			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("GET")
 
+	router.Handle("/v1/catalog/products/{productId}",
+		middlewares.ChainHandlerFuncMiddlewares(handler.updateProductByID,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("PATCH")
+
 	router.Handle("/v1/catalog/products/{productId}",
 		middlewares.ChainHandlerFuncMiddlewares(handler.deleteProductByID,
 			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("DELETE")
@@ -45,7 +57,39 @@ func (handler *productHandler) LoadRoutes(router *mux.Router) {
 		middlewares.ChainHandlerFuncMiddlewares(handler.AddVariant,
 			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
 
+	router.Handle("/v1/catalog/products/{productId}/variants/{variantId}",
+		middlewares.ChainHandlerFuncMiddlewares(handler.updateVariant,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("PATCH")
+
 	router.Handle("/v1/catalog/products/{productId}/variants/{variantId}",
 		middlewares.ChainHandlerFuncMiddlewares(handler.RemoveVariant,
 			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("DELETE")
+
+	router.Handle("/v1/catalog/products/{productId}/variants/{variantId}/quantity",
+		middlewares.ChainHandlerFuncMiddlewares(handler.adjustQuantity,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
+
+	router.Handle("/v1/catalog/products/{productId}/stock",
+		middlewares.ChainHandlerFuncMiddlewares(handler.updateStock,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("PUT")
+
+	router.Handle("/v1/catalog/quotes",
+		middlewares.ChainHandlerFuncMiddlewares(handler.quote,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
+
+	router.Handle("/v1/catalog/reservations",
+		middlewares.ChainHandlerFuncMiddlewares(handler.reserve,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
+
+	router.Handle("/v1/catalog/reservations/{orderId}/confirm",
+		middlewares.ChainHandlerFuncMiddlewares(handler.confirmReservation,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
+
+	router.Handle("/v1/catalog/reservations/{orderId}/release",
+		middlewares.ChainHandlerFuncMiddlewares(handler.releaseReservation,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/stock",
+		middlewares.ChainHandlerFuncMiddlewares(handler.bulkUpdateStock,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
 }
@@ -37,6 +37,10 @@ func (handler *restaurantHandler) LoadRoutes(router *mux.Router) {
 		middlewares.ChainHandlerFuncMiddlewares(handler.getRestaurantByID,
 			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("GET")
 
+	router.Handle("/v1/catalog/restaurants/{restaurantId}",
+		middlewares.ChainHandlerFuncMiddlewares(handler.updateRestaurantByID,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("PATCH")
+
 	router.Handle("/v1/catalog/restaurants/{restaurantId}",
 		middlewares.ChainHandlerFuncMiddlewares(handler.deleteRestaurantByID,
 			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("DELETE")
@@ -44,4 +48,44 @@ func (handler *restaurantHandler) LoadRoutes(router *mux.Router) {
 	router.Handle("/v1/catalog/restaurants",
 		middlewares.ChainHandlerFuncMiddlewares(handler.getAllRestaurants,
 			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("GET")
+
+	router.Handle("/v1/catalog/admin/restaurants",
+		middlewares.ChainHandlerFuncMiddlewares(handler.searchRestaurants,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("GET")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/menu",
+		middlewares.ChainHandlerFuncMiddlewares(handler.getMenu,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("GET")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/transitions",
+		middlewares.ChainHandlerFuncMiddlewares(handler.transitionRestaurant,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/exceptions",
+		middlewares.ChainHandlerFuncMiddlewares(handler.createScheduleException,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/exceptions",
+		middlewares.ChainHandlerFuncMiddlewares(handler.getScheduleExceptions,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("GET")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/exceptions/{exceptionId}",
+		middlewares.ChainHandlerFuncMiddlewares(handler.updateScheduleException,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("PUT")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/exceptions/{exceptionId}",
+		middlewares.ChainHandlerFuncMiddlewares(handler.deleteScheduleException,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("DELETE")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/members",
+		middlewares.ChainHandlerFuncMiddlewares(handler.inviteMember,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("POST")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/members",
+		middlewares.ChainHandlerFuncMiddlewares(handler.getMembers,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("GET")
+
+	router.Handle("/v1/catalog/restaurants/{restaurantId}/members/{userId}",
+		middlewares.ChainHandlerFuncMiddlewares(handler.removeMember,
+			authentication.AuthHandler(), middlewares.TimeoutHandler(2*time.Second))).Methods("DELETE")
 }
@@ -0,0 +1,41 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+)
+
+func (handler *restaurantHandler) searchRestaurants(w http.ResponseWriter, r *http.Request) {
+	var request restaurantUsecase.SearchRestaurantsRequest
+	ctx := r.Context()
+	auth, _ := authentication.GetAuthFromContext(ctx)
+	logger := handler.logger.WithContext(ctx)
+	queryParamsData := r.URL.Query()
+
+	decodeError := handler.schemaDecoder.Decode(&request, queryParamsData)
+	if decodeError != nil {
+		errorMsg := "Invalid request query Params"
+		logger.WithError(decodeError).Error(errorMsg)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"message": %q}`, errorMsg)
+		return
+	}
+
+	result, serviceError := handler.restaurantInteractor.SearchRestaurants(ctx, auth, request)
+	if serviceError != nil {
+		logger.WithError(serviceError).Error("Got Error from service")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(serviceError.StatusCode())
+		fmt.Fprintf(w, `{"message": %q}`, serviceError.Error())
+		return
+	}
+
+	w.Header().Set("Content-type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,71 @@
+package mergepatch
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+// Apply applies the JSON merge patch(RFC 7396) on the document pointed by doc.
+// Members of the patch with null value are removed from the document and
+// objects are merged recursively, every other value replaces the original one
+func Apply(doc interface{}, patch json.RawMessage) errors.AppError {
+	var patchObj interface{}
+	unmarshalError := json.Unmarshal(patch, &patchObj)
+	if unmarshalError != nil {
+		return errors.NewAppError("Invalid request", http.StatusBadRequest, unmarshalError)
+	}
+
+	if _, ok := patchObj.(map[string]interface{}); !ok {
+		return errors.NewAppError("Patch should be a JSON object", http.StatusBadRequest, nil)
+	}
+
+	original, marshalError := json.Marshal(doc)
+	if marshalError != nil {
+		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, marshalError)
+	}
+
+	var originalObj interface{}
+	unmarshalError = json.Unmarshal(original, &originalObj)
+	if unmarshalError != nil {
+		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, unmarshalError)
+	}
+
+	merged, marshalError := json.Marshal(merge(originalObj, patchObj))
+	if marshalError != nil {
+		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, marshalError)
+	}
+
+	// reset the document so that removed members don't survive the decoding
+	docValue := reflect.ValueOf(doc).Elem()
+	docValue.Set(reflect.Zero(docValue.Type()))
+
+	unmarshalError = json.Unmarshal(merged, doc)
+	if unmarshalError != nil {
+		return errors.NewAppError("Invalid request", http.StatusBadRequest, unmarshalError)
+	}
+	return nil
+}
+
+func merge(original interface{}, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	originalObj, ok := original.(map[string]interface{})
+	if !ok {
+		originalObj = map[string]interface{}{}
+	}
+
+	for key, value := range patchObj {
+		if value == nil {
+			delete(originalObj, key)
+			continue
+		}
+		originalObj[key] = merge(originalObj[key], value)
+	}
+	return originalObj
+}
@@ -0,0 +1,95 @@
+package product
+
+import (
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+// Product types
+const (
+	TypeSimple = "simple"
+	TypeCombo  = "combo"
+)
+
+// ComboChoice provides the schema definition for a component that can be chosen for a combo slot.
+// Any variant of the product can be chosen if variant id is not provided
+type ComboChoice struct {
+	ProductID string `bson:"product_id" json:"product_id" validate:"required"`
+	VariantID string `bson:"variant_id,omitempty" json:"variant_id,omitempty"`
+}
+
+// ComboSlot provides the schema definition for slot of the combo, customer picks one of its choices
+type ComboSlot struct {
+	ID      string        `bson:"_id" json:"id"`
+	Name    string        `bson:"name" json:"name" validate:"required,min=2,max=30"`
+	Choices []ComboChoice `bson:"choices" json:"choices" validate:"required,min=1,dive"`
+}
+
+// IsCombo checks if the product is a combo
+func (product Product) IsCombo() bool {
+	return product.Type == TypeCombo
+}
+
+// ComponentIDs returns the ids of the products that are part of the combo
+func (product Product) ComponentIDs() []string {
+	productIDs := []string{}
+	seen := map[string]bool{}
+	for _, slot := range product.Slots {
+		for _, choice := range slot.Choices {
+			if !seen[choice.ProductID] {
+				seen[choice.ProductID] = true
+				productIDs = append(productIDs, choice.ProductID)
+			}
+		}
+	}
+	return productIDs
+}
+
+// RefreshComboStock derives the availability of the combo from its own variants and its components.
+// Combo is in stock if every slot has at least one choice in stock
+func (product *Product) RefreshComboStock(components map[string]Product) {
+	product.RefreshStock()
+	for _, slot := range product.Slots {
+		if !product.InStock {
+			return
+		}
+
+		available := false
+		for _, choice := range slot.Choices {
+			if choice.isAvailable(components) {
+				available = true
+				break
+			}
+		}
+		product.InStock = available
+	}
+}
+
+// isAvailable checks if the chosen component is in stock
+func (choice ComboChoice) isAvailable(components map[string]Product) bool {
+	component, ok := components[choice.ProductID]
+	if !ok || !component.InStock {
+		return false
+	}
+	if choice.VariantID == "" {
+		return true
+	}
+	for _, variant := range component.Variants {
+		if variant.ID == choice.VariantID {
+			return variant.InStock != nil && *variant.InStock
+		}
+	}
+	return false
+}
+
+// validateSlots validates that only combos have slots
+func (product Product) validateSlots() errors.AppError {
+	if product.IsCombo() && len(product.Slots) == 0 {
+		return errors.NewAppError("Combo should have at least one slot", http.StatusBadRequest, nil)
+	}
+	if !product.IsCombo() && len(product.Slots) > 0 {
+		return errors.NewAppError("Only combo can have slots", http.StatusBadRequest, nil)
+	}
+	return nil
+}
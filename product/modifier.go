@@ -0,0 +1,35 @@
+package product
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+// ModifierOption provides the schema definition for an option of the modifier group
+type ModifierOption struct {
+	ID      string `bson:"_id" json:"id"`
+	Name    string `bson:"name" json:"name" validate:"required,min=2,max=30"`
+	Price   Price  `bson:"price" json:"price" validate:"required,dive"`
+	InStock *bool  `bson:"in_stock" json:"in_stock" validate:"required"`
+}
+
+// ModifierGroup provides the schema definition for modifier group(add-ons) of the product.
+// Customer can choose between MinSelections and MaxSelections options of the group
+type ModifierGroup struct {
+	ID            string           `bson:"_id" json:"id"`
+	Name          string           `bson:"name" json:"name" validate:"required,min=2,max=30"`
+	MinSelections int              `bson:"min_selections" json:"min_selections" validate:"gte=0"`
+	MaxSelections int              `bson:"max_selections" json:"max_selections" validate:"gte=1,gtefield=MinSelections"`
+	Options       []ModifierOption `bson:"options" json:"options" validate:"required,min=1,dive"`
+}
+
+// validateSelections validates min and max selections against the options of the group
+func (group ModifierGroup) validateSelections() errors.AppError {
+	if group.MaxSelections > len(group.Options) {
+		return errors.NewAppError(fmt.Sprintf("Max selections of modifier group '%s' exceeds its options",
+			group.Name), http.StatusBadRequest, nil)
+	}
+	return nil
+}
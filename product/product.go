@@ -16,16 +16,40 @@ type Price struct {
 	Currency string `bson:"currency" json:"currency" validate:"required"`
 }
 
-// Variant provides the schema definition for Products Variant
+// Variant provides the schema definition for Products Variant. Stock of the variant with
+// StockQuantity is derived from the quantity(see SyncQuantity), quantity is reset to
+// DailyStockQuantity every day in the time zone of the restaurant
 type Variant struct {
-	ID          string    `bson:"_id,omitempty" json:"id"`
-	ProductID   string    `bson:"product_id" json:"product_id"`
-	Name        string    `bson:"name" json:"name" validate:"required,min=2,max=30"`
-	Description string    `bson:"description" json:"description" validate:"max=120"`
-	Price       Price     `bson:"price" json:"price" validate:"required,dive"`
-	InStock     *bool     `bson:"in_stock"  json:"in_stock" validate:"required"`
-	CreatedAt   time.Time `bson:"created_at" json:"created_at"`
-	UpdatedAt   time.Time `bson:"updated_at" json:"updated_at"`
+	ID                 string    `bson:"_id,omitempty" json:"id"`
+	ProductID          string    `bson:"product_id" json:"product_id"`
+	Name               string    `bson:"name" json:"name" validate:"required,min=2,max=30"`
+	Description        string    `bson:"description" json:"description" validate:"max=120"`
+	Price              Price     `bson:"price" json:"price" validate:"required,dive"`
+	InStock            *bool     `bson:"in_stock"  json:"in_stock" validate:"required"`
+	StockQuantity      *int64    `bson:"stock_quantity,omitempty" json:"stock_quantity,omitempty" validate:"omitempty,gte=0"`
+	DailyStockQuantity *int64    `bson:"daily_stock_quantity,omitempty" json:"daily_stock_quantity,omitempty" validate:"omitempty,gte=0"`
+	StockResetOn       string    `bson:"stock_reset_on,omitempty" json:"-"`
+	CreatedAt          time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt          time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// IsQuantityTracked checks if the stock of the variant is tracked by its quantity
+func (variant Variant) IsQuantityTracked() bool {
+	return variant.StockQuantity != nil
+}
+
+// SyncQuantity derives the stock of the variant from its quantity if the stock is tracked
+// by quantity. Quantity starts from the daily quantity if it is not provided
+func (variant *Variant) SyncQuantity() {
+	if variant.StockQuantity == nil && variant.DailyStockQuantity != nil {
+		quantity := *variant.DailyStockQuantity
+		variant.StockQuantity = &quantity
+	}
+
+	if variant.StockQuantity != nil {
+		inStock := *variant.StockQuantity > 0
+		variant.InStock = &inStock
+	}
 }
 
 // Validate validates Variant schema
@@ -43,18 +67,24 @@ func (variant Variant) Validate(validate *validator.Validate) errors.AppError {
 	return nil
 }
 
-// Product provides the model definition for Product
+// Product provides the model definition for Product. InStock of the product is derived from
+// its variants and StockOverride(see RefreshStock) and modifier groups are stored along with it.
+// Combo is sold at the price of its variants and references the components of its slots
 type Product struct {
-	ID           string    `bson:"_id,omitempty" json:"id"`
-	RestaurantID string    `bson:"restaurant_id" json:"restaurant_id" validate:"required"`
-	CategoryID   string    `bson:"category_id" json:"category_id" validate:"required"`
-	Name         string    `bson:"name" json:"name" validate:"required,min=2,max=30"`
-	Description  string    `bson:"description" json:"description" validate:"max=120"`
-	IsVeg        bool      `bson:"is_veg" json:"is_veg"`
-	InStock      bool      `bson:"in_stock"  json:"in_stock" validate:"required"`
-	Variants     []Variant `bson:"variants" json:"variants,omitempty" validate:"required,dive"`
-	CreatedAt    time.Time `bson:"created_at" json:"created_at"`
-	UpdatedAt    time.Time `bson:"updated_at" json:"updated_at"`
+	ID             string          `bson:"_id,omitempty" json:"id"`
+	RestaurantID   string          `bson:"restaurant_id" json:"restaurant_id" validate:"required"`
+	CategoryID     string          `bson:"category_id" json:"category_id" validate:"required"`
+	Type           string          `bson:"type" json:"type" validate:"omitempty,oneof=simple combo"`
+	Name           string          `bson:"name" json:"name" validate:"required,min=2,max=30"`
+	Description    string          `bson:"description" json:"description" validate:"max=120"`
+	IsVeg          bool            `bson:"is_veg" json:"is_veg"`
+	InStock        bool            `bson:"in_stock"  json:"in_stock"`
+	StockOverride  *bool           `bson:"stock_override,omitempty" json:"stock_override,omitempty"`
+	Variants       []Variant       `bson:"variants" json:"variants,omitempty" validate:"required,min=1,dive"`
+	ModifierGroups []ModifierGroup `bson:"modifier_groups" json:"modifier_groups,omitempty" validate:"dive"`
+	Slots          []ComboSlot     `bson:"slots,omitempty" json:"slots,omitempty" validate:"dive"`
+	CreatedAt      time.Time       `bson:"created_at" json:"created_at"`
+	UpdatedAt      time.Time       `bson:"updated_at" json:"updated_at"`
 }
 
 // Validate validates Product schema
@@ -69,5 +99,29 @@ func (product Product) Validate(validate *validator.Validate) errors.AppError {
 		}
 		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
 	}
-	return nil
+
+	for _, group := range product.ModifierGroups {
+		selectionError := group.validateSelections()
+		if selectionError != nil {
+			return selectionError
+		}
+	}
+	return product.validateSlots()
+}
+
+// RefreshStock derives the availability of the product from its variants. Product is in stock
+// if any of its variants is in stock, unless it is explicitly marked out of stock by StockOverride
+func (product *Product) RefreshStock() {
+	inStock := false
+	for _, variant := range product.Variants {
+		if variant.InStock != nil && *variant.InStock {
+			inStock = true
+			break
+		}
+	}
+
+	if product.StockOverride != nil && !*product.StockOverride {
+		inStock = false
+	}
+	product.InStock = inStock
 }
@@ -0,0 +1,39 @@
+package product
+
+import (
+	"time"
+)
+
+// Reservation statuses
+const (
+	ReservationHeld      = "held"
+	ReservationConfirmed = "confirmed"
+	ReservationReleased  = "released"
+	ReservationExpired   = "expired"
+)
+
+// ReservationItem provides the schema definition for units of the variant held by the reservation.
+// Item is reserved once its units are taken out of the stock quantity of the variant
+type ReservationItem struct {
+	ProductID string `bson:"product_id" json:"product_id" validate:"required"`
+	VariantID string `bson:"variant_id" json:"variant_id" validate:"required"`
+	Quantity  int64  `bson:"quantity" json:"quantity" validate:"required,gte=1,lte=100"`
+	Reserved  bool   `bson:"reserved" json:"-"`
+}
+
+// Reservation provides the schema definition for stock held for an order. Held reservation is
+// confirmed or released by the order service, reservation which isn't confirmed by ExpiresAt
+// expires and its units go back to the stock. ReservedOn is the date of the reservation in the
+// time zone of the restaurant
+type Reservation struct {
+	ID           string            `bson:"_id,omitempty" json:"id"`
+	OrderID      string            `bson:"order_id" json:"order_id"`
+	RestaurantID string            `bson:"restaurant_id" json:"restaurant_id"`
+	Items        []ReservationItem `bson:"items" json:"items"`
+	Status       string            `bson:"status" json:"status"`
+	ExpiresAt    time.Time         `bson:"expires_at" json:"expires_at"`
+	ReservedOn   string            `bson:"reserved_on" json:"-"`
+	ClosedAt     *time.Time        `bson:"closed_at,omitempty" json:"closed_at,omitempty"`
+	CreatedAt    time.Time         `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time         `bson:"updated_at" json:"updated_at"`
+}
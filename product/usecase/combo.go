@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+// validateCombo validates that the components of the combo belong to the same restaurant
+// and are not combos themselves
+func (interactor *productInteractor) validateCombo(ctx context.Context, combo product.Product) errors.AppError {
+	components, getComponentsError := interactor.getComponents(ctx, []product.Product{combo})
+	if getComponentsError != nil {
+		return getComponentsError
+	}
+
+	for _, slot := range combo.Slots {
+		for _, choice := range slot.Choices {
+			component, ok := components[choice.ProductID]
+			if !ok || component.RestaurantID != combo.RestaurantID {
+				return errors.NewAppError("Component of the combo doesnot belong to the restaurant",
+					http.StatusBadRequest, nil)
+			}
+			if component.IsCombo() {
+				return errors.NewAppError("Combo can't be a component of another combo",
+					http.StatusBadRequest, nil)
+			}
+			if choice.VariantID != "" && !hasVariant(component, choice.VariantID) {
+				return errors.NewAppError("Variant is not part of the component of the combo",
+					http.StatusBadRequest, nil)
+			}
+		}
+	}
+	return nil
+}
+
+// getComponents returns the components of the combos mapped by their id
+func (interactor *productInteractor) getComponents(ctx context.Context,
+	combos []product.Product) (map[string]product.Product, errors.AppError) {
+
+	productIDs := []string{}
+	for _, combo := range combos {
+		productIDs = append(productIDs, combo.ComponentIDs()...)
+	}
+
+	components := map[string]product.Product{}
+	if len(productIDs) == 0 {
+		return components, nil
+	}
+
+	products, getProductsError := interactor.productRepository.GetProductsByIDs(ctx, productIDs)
+	if getProductsError != nil {
+		return components, getProductsError
+	}
+	for _, productObj := range products {
+		components[productObj.ID] = productObj
+	}
+	return components, nil
+}
+
+// retainSlotIDs clears the ids of the combo slots that are not part of the existing slots,
+// repository assigns new ids to them
+func retainSlotIDs(slots []product.ComboSlot, existing []product.ComboSlot) {
+	existingSlots := map[string]bool{}
+	for _, slot := range existing {
+		existingSlots[slot.ID] = true
+	}
+
+	retainedSlots := map[string]bool{}
+	for i := range slots {
+		if !existingSlots[slots[i].ID] || retainedSlots[slots[i].ID] {
+			slots[i].ID = ""
+		}
+		retainedSlots[slots[i].ID] = true
+	}
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+)
+
+// retainModifierIDs clears the ids of the modifier groups and options that are not part of
+// the existing groups, repository assigns new ids to them
+func retainModifierIDs(groups []product.ModifierGroup, existing []product.ModifierGroup) {
+	existingOptions := map[string]map[string]bool{}
+	for _, group := range existing {
+		options := map[string]bool{}
+		for _, option := range group.Options {
+			options[option.ID] = true
+		}
+		existingOptions[group.ID] = options
+	}
+
+	retainedGroups := map[string]bool{}
+	for i := range groups {
+		options, ok := existingOptions[groups[i].ID]
+		if !ok || retainedGroups[groups[i].ID] {
+			groups[i].ID = ""
+			options = map[string]bool{}
+		}
+		retainedGroups[groups[i].ID] = true
+
+		retainedOptions := map[string]bool{}
+		for j := range groups[i].Options {
+			optionID := groups[i].Options[j].ID
+			if !options[optionID] || retainedOptions[optionID] {
+				groups[i].Options[j].ID = ""
+			}
+			retainedOptions[groups[i].Options[j].ID] = true
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+func (interactor *productInteractor) BatchGetProducts(ctx context.Context, auth authentication.Auth,
+	request BatchGetProductsRequest) (BatchGetProductsResponse, errors.AppError) {
+
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return BatchGetProductsResponse{}, validationError
+	}
+
+	products, repositoryError := interactor.productRepository.GetProductsByIDs(ctx, request.ProductIDs)
+	if repositoryError != nil {
+		return BatchGetProductsResponse{}, repositoryError
+	}
+	productMap := make(map[string]product.Product, len(products))
+	restaurantIDs := []string{}
+	seenRestaurants := map[string]bool{}
+	for _, productObj := range products {
+		productMap[productObj.ID] = productObj
+		if !seenRestaurants[productObj.RestaurantID] {
+			seenRestaurants[productObj.RestaurantID] = true
+			restaurantIDs = append(restaurantIDs, productObj.RestaurantID)
+		}
+	}
+
+	// restaurants are checked once, products of the restaurants which the user
+	// can't read are reported as not found
+	restaurants, getRestaurantsError := interactor.restaurantInteractor.GetByIDs(ctx, auth, restaurantIDs)
+	if getRestaurantsError != nil {
+		return BatchGetProductsResponse{}, getRestaurantsError
+	}
+	visibleRestaurants := map[string]bool{}
+	for _, restaurantObj := range restaurants {
+		visibleRestaurants[restaurantObj.ID] = true
+	}
+
+	response := BatchGetProductsResponse{
+		Products: []product.Product{},
+		NotFound: []string{},
+	}
+	seenProducts := map[string]bool{}
+	for _, productID := range request.ProductIDs {
+		if seenProducts[productID] {
+			continue
+		}
+		seenProducts[productID] = true
+
+		productObj, ok := productMap[productID]
+		if ok && visibleRestaurants[productObj.RestaurantID] {
+			response.Products = append(response.Products, productObj)
+		} else {
+			response.NotFound = append(response.NotFound, productID)
+		}
+	}
+	return response, nil
+}
+
+// BatchGetProductsRequest provides the schema definition for batch get products request
+type BatchGetProductsRequest struct {
+	ProductIDs []string `json:"product_ids" validate:"required,min=1,max=500"`
+}
+
+// Validate validates BatchGetProductsRequest
+func (request BatchGetProductsRequest) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("validation for field '%s' failed on '%s'", err.Field(), err.Tag())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+// BatchGetProductsResponse provides the schema definition for batch get products response.
+// Products are returned in the requested order along with the ids which were not found
+type BatchGetProductsResponse struct {
+	Products []product.Product `json:"products"`
+	NotFound []string          `json:"not_found"`
+}
@@ -3,9 +3,11 @@ package usecase
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
 	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
 	"github.com/dhyaniarun1993/foody-common/authentication"
 	"github.com/dhyaniarun1993/foody-common/errors"
 )
@@ -13,6 +15,13 @@ import (
 func (interactor *productInteractor) CreateProduct(ctx context.Context, auth authentication.Auth,
 	productObj product.Product) (product.Product, errors.AppError) {
 
+	if productObj.Type == "" {
+		productObj.Type = product.TypeSimple
+	}
+	for i := range productObj.Variants {
+		productObj.Variants[i].SyncQuantity()
+	}
+
 	// validate product schema
 	validationError := productObj.Validate(interactor.validator)
 	if validationError != nil {
@@ -41,13 +50,40 @@ func (interactor *productInteractor) CreateProduct(ctx context.Context, auth aut
 	}
 
 	// check if user have permission to create product
-	if (restaurant.MerchantID == auth.GetUserID() &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteOwn)) ||
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteAny) {
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+
+		now := time.Now()
+		for i := range productObj.Variants {
+			syncDailyStock(&productObj.Variants[i], product.Variant{}, restaurant.TimeZone, now)
+		}
+		retainModifierIDs(productObj.ModifierGroups, nil)
+		retainSlotIDs(productObj.Slots, nil)
+
+		if productObj.IsCombo() {
+			comboError := interactor.validateCombo(ctx, productObj)
+			if comboError != nil {
+				return product.Product{}, comboError
+			}
+		}
+
+		// availability of the product is derived from its variants
+		deriveError := interactor.deriveStock(ctx, &productObj)
+		if deriveError != nil {
+			return product.Product{}, deriveError
+		}
 
-		var createProductError errors.AppError
-		productObj, createProductError = interactor.productRepository.CreateProduct(ctx, productObj)
-		return productObj, createProductError
+		// product is created along with its variants, product is only returned once it is committed
+		var createdProduct product.Product
+		createProductError := interactor.unitOfWork.Do(ctx, func(ctx context.Context) errors.AppError {
+			var repositoryError errors.AppError
+			createdProduct, repositoryError = interactor.productRepository.CreateProduct(ctx, productObj)
+			return repositoryError
+		})
+		if createProductError != nil {
+			return product.Product{}, createProductError
+		}
+		return createdProduct, nil
 	}
 	return product.Product{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
 
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
 	"github.com/dhyaniarun1993/foody-common/authentication"
 	"github.com/dhyaniarun1993/foody-common/errors"
 )
@@ -24,12 +25,24 @@ func (interactor *productInteractor) DeleteProductByID(ctx context.Context, auth
 		return getRestaurantError
 	}
 
-	if (restaurant.MerchantID == auth.GetUserID() &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteOwn)) ||
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteAny) {
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite,
+		restaurantUsecase.Resource(restaurant)).Allowed {
 
-		repositoryError := interactor.productRepository.DeleteProductByID(ctx, productID)
-		return repositoryError
+		// product is deleted along with its variants, product can't be deleted while it is
+		// a component of any combo
+		return interactor.unitOfWork.Do(ctx, func(ctx context.Context) errors.AppError {
+			combos, getCombosError := interactor.productRepository.GetCombosByComponentIDs(ctx,
+				[]string{productID})
+			if getCombosError != nil {
+				return getCombosError
+			}
+			if len(combos) > 0 {
+				return errors.NewAppError("Product is part of combos, remove it from combos first",
+					http.StatusConflict, nil)
+			}
+
+			return interactor.productRepository.DeleteProductByID(ctx, productID)
+		})
 	}
 	return errors.NewAppError("Forbidden", http.StatusForbidden, nil)
 }
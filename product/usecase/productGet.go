@@ -7,6 +7,7 @@ import (
 
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
 	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
 	"github.com/dhyaniarun1993/foody-common/authentication"
 	"github.com/dhyaniarun1993/foody-common/errors"
 )
@@ -32,9 +33,8 @@ func (interactor *productInteractor) GetProductByID(ctx context.Context, auth au
 		return product.Product{}, getRestaurantError
 	}
 
-	if (restaurant.MerchantID == auth.GetUserID() &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogReadOwn)) ||
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogReadAny) {
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionRead,
+		restaurantUsecase.Resource(restaurant)).Allowed {
 
 		return productObj, nil
 	}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/async"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+func (interactor *productInteractor) GetAllProducts(ctx context.Context, auth authentication.Auth,
+	request GetAllProductsRequest) (GetAllProductsResponse, errors.AppError) {
+
+	var products []product.Product
+	var totalCount int64
+	var productResponse GetAllProductsResponse
+
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return productResponse, validationError
+	}
+
+	// user should have permission to get the restaurant
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx, auth,
+		request.RestaurantID)
+	if getRestaurantError != nil {
+		return productResponse, getRestaurantError
+	}
+
+	async, asyncCtx := async.WithContext(ctx)
+
+	if request.PageNumber == 0 {
+		request.PageNumber = 1
+	}
+	if request.PageSize == 0 {
+		request.PageSize = 50
+	}
+
+	getAllProducts := func() errors.AppError {
+		var repositoryError errors.AppError
+		products, repositoryError = interactor.productRepository.GetAllProducts(asyncCtx, request)
+		return repositoryError
+	}
+
+	getTotalCount := func() errors.AppError {
+		var repositoryError errors.AppError
+		totalCount, repositoryError = interactor.productRepository.GetAllProductsTotalCount(asyncCtx, request)
+		return repositoryError
+	}
+
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionRead,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+
+		async.Go(getAllProducts)
+		async.Go(getTotalCount)
+		err := async.Wait()
+		if err != nil {
+			return productResponse, err
+		}
+
+		productResponse = GetAllProductsResponse{
+			Total:      totalCount,
+			PageNumber: request.PageNumber,
+			PageSize:   request.PageSize,
+			TotalPages: int64(math.Ceil(float64(totalCount) / float64(request.PageSize))),
+			Products:   products,
+		}
+		return productResponse, nil
+	}
+	return productResponse, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
+
+// GetAllProductsRequest provides the schema definition for get all products request
+type GetAllProductsRequest struct {
+	PageNumber   int64  `schema:"pageNumber" json:"pageNumber" validate:"gte=0"`
+	PageSize     int64  `schema:"pageSize" json:"pageSize" validate:"lte=100"`
+	RestaurantID string `schema:"restaurantId" json:"restaurantId" validate:"required"`
+	CategoryID   string `schema:"categoryId" json:"categoryId"`
+	IsVeg        *bool  `schema:"isVeg" json:"isVeg"`
+	InStock      *bool  `schema:"inStock" json:"inStock"`
+}
+
+// Validate validates GetAllProductsRequest
+func (request GetAllProductsRequest) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("validation for field '%s' failed on '%s'", err.Field(), err.Tag())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+// GetAllProductsResponse provides the schema definition for get all products response
+type GetAllProductsResponse struct {
+	Total      int64             `json:"total"`
+	PageNumber int64             `json:"page_number"`
+	PageSize   int64             `json:"page_size"`
+	TotalPages int64             `json:"total_pages"`
+	Products   []product.Product `json:"products"`
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/mergepatch"
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *productInteractor) UpdateProduct(ctx context.Context, auth authentication.Auth,
+	productID string, patch json.RawMessage) (product.Product, errors.AppError) {
+
+	// check if product exist
+	productObj, getProductError := interactor.GetProductByID(ctx, auth, productID)
+	if getProductError != nil {
+		return product.Product{}, getProductError
+	}
+
+	// user should have permission to get the restaurant
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx, auth,
+		productObj.RestaurantID)
+	if getRestaurantError != nil {
+		return product.Product{}, getRestaurantError
+	}
+
+	// check if user have permission to update product
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+
+		updatedProduct := productObj
+		patchError := mergepatch.Apply(&updatedProduct, patch)
+		if patchError != nil {
+			return product.Product{}, patchError
+		}
+
+		// fields managed by the service cannot be patched, variants are updated
+		// through their own endpoints and stock is derived from them
+		updatedProduct.ID = productObj.ID
+		updatedProduct.RestaurantID = productObj.RestaurantID
+		updatedProduct.Type = productObj.Type
+		updatedProduct.Variants = productObj.Variants
+		updatedProduct.CreatedAt = productObj.CreatedAt
+		retainModifierIDs(updatedProduct.ModifierGroups, productObj.ModifierGroups)
+		retainSlotIDs(updatedProduct.Slots, productObj.Slots)
+
+		validationError := updatedProduct.Validate(interactor.validator)
+		if validationError != nil {
+			return product.Product{}, validationError
+		}
+
+		// product can only be moved to the category of the same restaurant
+		if updatedProduct.CategoryID != productObj.CategoryID {
+			// user should have permission to get the category
+			category, getCategoryError := interactor.categoryInteractor.GetByID(ctx, auth,
+				updatedProduct.CategoryID)
+			if getCategoryError != nil {
+				return product.Product{}, getCategoryError
+			}
+
+			if category.RestaurantID != restaurant.ID {
+				return product.Product{}, errors.NewAppError("Category doesnot belong to the restaurant",
+					http.StatusBadRequest, nil)
+			}
+		}
+
+		if updatedProduct.IsCombo() {
+			comboError := interactor.validateCombo(ctx, updatedProduct)
+			if comboError != nil {
+				return product.Product{}, comboError
+			}
+		}
+
+		deriveError := interactor.deriveStock(ctx, &updatedProduct)
+		if deriveError != nil {
+			return product.Product{}, deriveError
+		}
+
+		var repositoryError errors.AppError
+		updatedProduct, repositoryError = interactor.productRepository.UpdateProduct(ctx, updatedProduct)
+		if repositoryError != nil {
+			return product.Product{}, repositoryError
+		}
+
+		// stock override of the product might have changed
+		refreshError := interactor.refreshCombos(ctx, []string{updatedProduct.ID})
+		return updatedProduct, refreshError
+	}
+	return product.Product{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
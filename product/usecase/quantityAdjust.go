@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+func (interactor *productInteractor) AdjustQuantity(ctx context.Context, auth authentication.Auth,
+	productID string, variantID string, request AdjustQuantityRequest) (product.Variant, errors.AppError) {
+
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return product.Variant{}, validationError
+	}
+
+	productObj, getProductError := interactor.GetProductByID(ctx, auth, productID)
+	if getProductError != nil {
+		return product.Variant{}, getProductError
+	}
+
+	// user should have permission to get restaurant
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx,
+		auth, productObj.RestaurantID)
+	if getRestaurantError != nil {
+		return product.Variant{}, getRestaurantError
+	}
+
+	// quantity is adjusted by the restaurant staff and by the services placing the orders
+	if !interactor.authorizer.Authorize(ctx, auth, acl.ActionAdjustStock,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+		return product.Variant{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+	}
+
+	for i := range productObj.Variants {
+		if productObj.Variants[i].ID != variantID {
+			continue
+		}
+		if !productObj.Variants[i].IsQuantityTracked() {
+			return product.Variant{}, errors.NewAppError("Stock of the variant is not tracked by quantity",
+				http.StatusBadRequest, nil)
+		}
+
+		variant, repositoryError := interactor.productRepository.AdjustVariantQuantity(ctx, variantID,
+			*request.Delta)
+		if repositoryError != nil {
+			return product.Variant{}, repositoryError
+		}
+
+		// availability of the product only changes when the variant runs out or is restocked
+		if *productObj.Variants[i].InStock == *variant.InStock {
+			return variant, nil
+		}
+		productObj.Variants[i] = variant
+		refreshError := interactor.refreshProductStock(ctx, &productObj)
+		return variant, refreshError
+	}
+	return product.Variant{}, errors.NewAppError("Variant is not part of the provided product",
+		http.StatusBadRequest, nil)
+}
+
+// AdjustQuantityRequest provides the schema definition for variant quantity adjust request.
+// Negative delta decrements the quantity
+type AdjustQuantityRequest struct {
+	Delta *int64 `json:"delta" validate:"required,ne=0"`
+}
+
+// Validate validates AdjustQuantityRequest
+func (request AdjustQuantityRequest) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("validation for field '%s' failed on '%s'", err.Field(), err.Tag())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
@@ -0,0 +1,352 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// Quote line errors
+const (
+	QuoteErrorNotFound            = "not_found"
+	QuoteErrorWrongRestaurant     = "wrong_restaurant"
+	QuoteErrorOutOfStock          = "out_of_stock"
+	QuoteErrorInvalidModifiers    = "invalid_modifiers"
+	QuoteErrorInvalidComboChoices = "invalid_combo_choices"
+)
+
+func (interactor *productInteractor) Quote(ctx context.Context, auth authentication.Auth,
+	request QuoteRequest) (QuoteResponse, errors.AppError) {
+
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return QuoteResponse{}, validationError
+	}
+
+	// user should have permission to get restaurant
+	restaurantObj, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx, auth,
+		request.RestaurantID)
+	if getRestaurantError != nil {
+		return QuoteResponse{}, getRestaurantError
+	}
+
+	if !interactor.authorizer.Authorize(ctx, auth, acl.ActionQuote,
+		restaurantUsecase.Resource(restaurantObj)).Allowed {
+		return QuoteResponse{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+	}
+
+	// products of the lines and components of the combos are read together
+	productIDs := []string{}
+	for _, item := range request.Items {
+		productIDs = append(productIDs, item.ProductID)
+		for _, choice := range item.ComboChoices {
+			productIDs = append(productIDs, choice.ProductID)
+		}
+	}
+	products, getProductsError := interactor.productRepository.GetProductsByIDs(ctx, productIDs)
+	if getProductsError != nil {
+		return QuoteResponse{}, getProductsError
+	}
+	productMap := make(map[string]product.Product, len(products))
+	for _, productObj := range products {
+		productMap[productObj.ID] = productObj
+	}
+
+	response := QuoteResponse{
+		RestaurantID:   restaurantObj.ID,
+		RestaurantOpen: restaurantObj.IsOpen,
+		RestaurantFees: restaurantObj.RestaurantFees,
+		Lines:          make([]QuoteLine, 0, len(request.Items)),
+		Valid:          restaurantObj.IsOpen,
+	}
+	requested := requestedQuantities(request.Items, productMap)
+	for _, item := range request.Items {
+		line := quoteLine(item, restaurantObj.ID, productMap, requested)
+		if line.Error != "" {
+			response.Valid = false
+		}
+		response.Lines = append(response.Lines, line)
+	}
+	return response, nil
+}
+
+// quoteLine prices the line item, line which can't be ordered is not priced and has the error set.
+// Requested is the quantity of the variants requested by all the lines of the quote
+func quoteLine(item QuoteItem, restaurantID string, products map[string]product.Product,
+	requested map[string]int64) QuoteLine {
+	line := QuoteLine{
+		ProductID: item.ProductID,
+		VariantID: item.VariantID,
+		Quantity:  item.Quantity,
+	}
+
+	productObj, ok := products[item.ProductID]
+	if !ok {
+		line.Error = QuoteErrorNotFound
+		return line
+	}
+	if productObj.RestaurantID != restaurantID {
+		line.Error = QuoteErrorWrongRestaurant
+		return line
+	}
+
+	variant, ok := findVariant(productObj, item.VariantID)
+	if !ok {
+		line.Error = QuoteErrorNotFound
+		return line
+	}
+	if !productObj.InStock || !hasQuantity(variant, requested[variant.ID]) {
+		line.Error = QuoteErrorOutOfStock
+		return line
+	}
+
+	modifiersAmount, modifiersError := priceModifiers(productObj, item.Modifiers, variant.Price.Currency)
+	if modifiersError != "" {
+		line.Error = modifiersError
+		return line
+	}
+
+	comboError := validateComboChoices(productObj, item.ComboChoices, products, requested)
+	if comboError != "" {
+		line.Error = comboError
+		return line
+	}
+
+	unitAmount := variant.Price.Amount + modifiersAmount
+	line.UnitPrice = &product.Price{
+		Amount:   roundAmount(unitAmount),
+		Currency: variant.Price.Currency,
+	}
+	line.LinePrice = &product.Price{
+		Amount:   roundAmount(unitAmount * float64(item.Quantity)),
+		Currency: variant.Price.Currency,
+	}
+	return line
+}
+
+// priceModifiers validates the selected modifier options against the min and max selections
+// of the modifier groups and returns the total amount of the options
+func priceModifiers(productObj product.Product, optionIDs []string, currency string) (float64, string) {
+	amount := 0.0
+	selected := map[string]bool{}
+	groupSelections := map[string]int{}
+	for _, optionID := range optionIDs {
+		group, option, ok := findModifierOption(productObj, optionID)
+		if !ok || selected[optionID] || option.Price.Currency != currency {
+			return 0, QuoteErrorInvalidModifiers
+		}
+		if option.InStock == nil || !*option.InStock {
+			return 0, QuoteErrorOutOfStock
+		}
+
+		selected[optionID] = true
+		groupSelections[group.ID]++
+		amount += option.Price.Amount
+	}
+
+	for _, group := range productObj.ModifierGroups {
+		if groupSelections[group.ID] < group.MinSelections || groupSelections[group.ID] > group.MaxSelections {
+			return 0, QuoteErrorInvalidModifiers
+		}
+	}
+	return amount, ""
+}
+
+// validateComboChoices validates that exactly one available choice is picked for every slot of the combo
+func validateComboChoices(combo product.Product, choices []QuoteComboChoice,
+	products map[string]product.Product, requested map[string]int64) string {
+
+	if !combo.IsCombo() {
+		if len(choices) > 0 {
+			return QuoteErrorInvalidComboChoices
+		}
+		return ""
+	}
+
+	chosenSlots := map[string]bool{}
+	for _, choice := range choices {
+		slot, ok := findSlot(combo, choice.SlotID)
+		if !ok || chosenSlots[slot.ID] || !slotHasChoice(slot, choice) {
+			return QuoteErrorInvalidComboChoices
+		}
+		chosenSlots[slot.ID] = true
+
+		component, ok := products[choice.ProductID]
+		if !ok {
+			return QuoteErrorInvalidComboChoices
+		}
+		if !component.InStock {
+			return QuoteErrorOutOfStock
+		}
+		variant, ok := choiceVariant(component, choice)
+		if !ok {
+			return QuoteErrorInvalidComboChoices
+		}
+		if variant.ID != "" && !hasQuantity(variant, requested[variant.ID]) {
+			return QuoteErrorOutOfStock
+		}
+	}
+
+	if len(chosenSlots) != len(combo.Slots) {
+		return QuoteErrorInvalidComboChoices
+	}
+	return ""
+}
+
+// choiceVariant returns the variant picked for the combo choice. Choice without variant picks the only
+// variant of the component, variant of the component with more variants is only required if any of
+// them is tracked by quantity, otherwise empty variant is returned as the stock of the component applies
+func choiceVariant(component product.Product, choice QuoteComboChoice) (product.Variant, bool) {
+	if choice.VariantID != "" {
+		return findVariant(component, choice.VariantID)
+	}
+	if len(component.Variants) == 1 {
+		return component.Variants[0], true
+	}
+	for _, variant := range component.Variants {
+		if variant.IsQuantityTracked() {
+			return product.Variant{}, false
+		}
+	}
+	return product.Variant{}, true
+}
+
+// requestedQuantities returns the quantity of the variants requested by the items including
+// the components of the combos, stock of the variant should cover all the lines requesting it
+func requestedQuantities(items []QuoteItem, products map[string]product.Product) map[string]int64 {
+	requested := map[string]int64{}
+	for _, item := range items {
+		requested[item.VariantID] += item.Quantity
+		for _, choice := range item.ComboChoices {
+			variant, ok := choiceVariant(products[choice.ProductID], choice)
+			if ok && variant.ID != "" {
+				requested[variant.ID] += item.Quantity
+			}
+		}
+	}
+	return requested
+}
+
+// hasQuantity checks if the variant is in stock and has the quantity if its stock is tracked by quantity
+func hasQuantity(variant product.Variant, quantity int64) bool {
+	if variant.InStock == nil || !*variant.InStock {
+		return false
+	}
+	return !variant.IsQuantityTracked() || *variant.StockQuantity >= quantity
+}
+
+// findVariant returns the variant of the product
+func findVariant(productObj product.Product, variantID string) (product.Variant, bool) {
+	for _, variant := range productObj.Variants {
+		if variant.ID == variantID {
+			return variant, true
+		}
+	}
+	return product.Variant{}, false
+}
+
+// findModifierOption returns the modifier option of the product along with its group
+func findModifierOption(productObj product.Product,
+	optionID string) (product.ModifierGroup, product.ModifierOption, bool) {
+
+	for _, group := range productObj.ModifierGroups {
+		for _, option := range group.Options {
+			if option.ID == optionID {
+				return group, option, true
+			}
+		}
+	}
+	return product.ModifierGroup{}, product.ModifierOption{}, false
+}
+
+// findSlot returns the slot of the combo
+func findSlot(combo product.Product, slotID string) (product.ComboSlot, bool) {
+	for _, slot := range combo.Slots {
+		if slot.ID == slotID {
+			return slot, true
+		}
+	}
+	return product.ComboSlot{}, false
+}
+
+// slotHasChoice checks if the picked component is one of the choices of the slot
+func slotHasChoice(slot product.ComboSlot, choice QuoteComboChoice) bool {
+	for _, slotChoice := range slot.Choices {
+		if slotChoice.ProductID == choice.ProductID &&
+			(slotChoice.VariantID == "" || slotChoice.VariantID == choice.VariantID) {
+			return true
+		}
+	}
+	return false
+}
+
+// roundAmount rounds the amount to two decimal places
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// QuoteComboChoice provides the schema definition for component picked for a slot of the combo
+type QuoteComboChoice struct {
+	SlotID    string `json:"slot_id" validate:"required"`
+	ProductID string `json:"product_id" validate:"required"`
+	VariantID string `json:"variant_id"`
+}
+
+// QuoteItem provides the schema definition for line item of the quote request.
+// Modifiers are the ids of the selected modifier options
+type QuoteItem struct {
+	ProductID    string             `json:"product_id" validate:"required"`
+	VariantID    string             `json:"variant_id" validate:"required"`
+	Modifiers    []string           `json:"modifiers" validate:"max=50"`
+	ComboChoices []QuoteComboChoice `json:"combo_choices" validate:"max=20,dive"`
+	Quantity     int64              `json:"quantity" validate:"required,gte=1,lte=100"`
+}
+
+// QuoteRequest provides the schema definition for quote request
+type QuoteRequest struct {
+	RestaurantID string      `json:"restaurant_id" validate:"required"`
+	Items        []QuoteItem `json:"items" validate:"required,min=1,max=100,dive"`
+}
+
+// Validate validates QuoteRequest
+func (request QuoteRequest) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("validation for field '%s' failed on '%s'", err.Field(), err.Tag())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+// QuoteLine provides the schema definition for priced line item of the quote
+type QuoteLine struct {
+	ProductID string         `json:"product_id"`
+	VariantID string         `json:"variant_id"`
+	Quantity  int64          `json:"quantity"`
+	UnitPrice *product.Price `json:"unit_price,omitempty"`
+	LinePrice *product.Price `json:"line_price,omitempty"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// QuoteResponse provides the schema definition for quote response. Quote is valid if
+// the restaurant is open and every line can be ordered
+type QuoteResponse struct {
+	RestaurantID   string          `json:"restaurant_id"`
+	RestaurantOpen bool            `json:"restaurant_open"`
+	RestaurantFees restaurant.Fees `json:"restaurant_fees"`
+	Lines          []QuoteLine     `json:"lines"`
+	Valid          bool            `json:"valid"`
+}
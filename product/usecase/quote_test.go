@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+)
+
+func quoteTestProducts() map[string]product.Product {
+	inStock := true
+	quantity := func(quantity int64) *int64 {
+		return &quantity
+	}
+	usd := func(amount float64) product.Price {
+		return product.Price{Amount: amount, Currency: "USD"}
+	}
+
+	products := []product.Product{
+		{
+			ID:           "burger",
+			RestaurantID: "restaurant",
+			Type:         product.TypeSimple,
+			InStock:      true,
+			Variants: []product.Variant{
+				{ID: "burger-regular", Price: usd(5), InStock: &inStock, StockQuantity: quantity(15)},
+			},
+			ModifierGroups: []product.ModifierGroup{
+				{
+					ID:            "toppings",
+					MinSelections: 0,
+					MaxSelections: 2,
+					Options: []product.ModifierOption{
+						{ID: "cheese", Price: usd(1), InStock: &inStock},
+						{ID: "bacon", Price: usd(2), InStock: &inStock},
+						{ID: "truffle", Price: product.Price{Amount: 1, Currency: "EUR"}, InStock: &inStock},
+					},
+				},
+				{
+					ID:            "sauce",
+					MinSelections: 1,
+					MaxSelections: 1,
+					Options: []product.ModifierOption{
+						{ID: "ketchup", Price: usd(0), InStock: &inStock},
+						{ID: "mayo", Price: usd(0.5), InStock: &inStock},
+					},
+				},
+			},
+		},
+		{
+			ID:           "fries",
+			RestaurantID: "restaurant",
+			Type:         product.TypeSimple,
+			InStock:      true,
+			Variants: []product.Variant{
+				{ID: "fries-small", Price: usd(2), InStock: &inStock, StockQuantity: quantity(3)},
+				{ID: "fries-large", Price: usd(3), InStock: &inStock},
+			},
+		},
+		{
+			ID:           "drink",
+			RestaurantID: "restaurant",
+			Type:         product.TypeSimple,
+			InStock:      true,
+			Variants: []product.Variant{
+				{ID: "drink-can", Price: usd(1), InStock: &inStock, StockQuantity: quantity(2)},
+			},
+		},
+		{
+			ID:           "meal",
+			RestaurantID: "restaurant",
+			Type:         product.TypeCombo,
+			InStock:      true,
+			Variants: []product.Variant{
+				{ID: "meal-regular", Price: usd(8), InStock: &inStock},
+			},
+			Slots: []product.ComboSlot{
+				{ID: "main", Choices: []product.ComboChoice{{ProductID: "burger", VariantID: "burger-regular"}}},
+				{ID: "side", Choices: []product.ComboChoice{{ProductID: "fries"}}},
+				{ID: "beverage", Choices: []product.ComboChoice{{ProductID: "drink"}}},
+			},
+		},
+	}
+
+	productMap := make(map[string]product.Product, len(products))
+	for _, productObj := range products {
+		productMap[productObj.ID] = productObj
+	}
+	return productMap
+}
+
+func burger(quantity int64, modifiers ...string) QuoteItem {
+	return QuoteItem{ProductID: "burger", VariantID: "burger-regular", Quantity: quantity, Modifiers: modifiers}
+}
+
+func meal(quantity int64, choices ...QuoteComboChoice) QuoteItem {
+	return QuoteItem{ProductID: "meal", VariantID: "meal-regular", Quantity: quantity, ComboChoices: choices}
+}
+
+var (
+	mainChoice     = QuoteComboChoice{SlotID: "main", ProductID: "burger", VariantID: "burger-regular"}
+	sideChoice     = QuoteComboChoice{SlotID: "side", ProductID: "fries", VariantID: "fries-large"}
+	beverageChoice = QuoteComboChoice{SlotID: "beverage", ProductID: "drink"}
+)
+
+func TestQuoteLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		items      []QuoteItem
+		errors     []string
+		linePrices []float64
+	}{
+		{
+			name:       "modifiers are priced",
+			items:      []QuoteItem{burger(2, "cheese", "bacon", "mayo")},
+			errors:     []string{""},
+			linePrices: []float64{17},
+		},
+		{
+			name:   "min selections of group are not met",
+			items:  []QuoteItem{burger(1, "cheese")},
+			errors: []string{QuoteErrorInvalidModifiers},
+		},
+		{
+			name:   "max selections of group are exceeded",
+			items:  []QuoteItem{burger(1, "ketchup", "mayo")},
+			errors: []string{QuoteErrorInvalidModifiers},
+		},
+		{
+			name:   "duplicate option",
+			items:  []QuoteItem{burger(1, "cheese", "cheese", "ketchup")},
+			errors: []string{QuoteErrorInvalidModifiers},
+		},
+		{
+			name:   "unknown option",
+			items:  []QuoteItem{burger(1, "pickles", "ketchup")},
+			errors: []string{QuoteErrorInvalidModifiers},
+		},
+		{
+			name:   "option in other currency",
+			items:  []QuoteItem{burger(1, "truffle", "ketchup")},
+			errors: []string{QuoteErrorInvalidModifiers},
+		},
+		{
+			name:       "combo with every slot chosen",
+			items:      []QuoteItem{meal(2, mainChoice, sideChoice, beverageChoice)},
+			errors:     []string{""},
+			linePrices: []float64{16},
+		},
+		{
+			name:   "combo with missing slot",
+			items:  []QuoteItem{meal(1, mainChoice, sideChoice)},
+			errors: []string{QuoteErrorInvalidComboChoices},
+		},
+		{
+			name:   "combo with slot chosen twice",
+			items:  []QuoteItem{meal(1, mainChoice, sideChoice, beverageChoice, mainChoice)},
+			errors: []string{QuoteErrorInvalidComboChoices},
+		},
+		{
+			name: "combo with choice of other slot",
+			items: []QuoteItem{meal(1, mainChoice, sideChoice,
+				QuoteComboChoice{SlotID: "beverage", ProductID: "fries", VariantID: "fries-large"})},
+			errors: []string{QuoteErrorInvalidComboChoices},
+		},
+		{
+			name: "combo choice without variant of component with tracked variants",
+			items: []QuoteItem{meal(1, mainChoice, beverageChoice,
+				QuoteComboChoice{SlotID: "side", ProductID: "fries"})},
+			errors: []string{QuoteErrorInvalidComboChoices},
+		},
+		{
+			name: "choices of product which is not combo",
+			items: []QuoteItem{
+				{ProductID: "drink", VariantID: "drink-can", Quantity: 1, ComboChoices: []QuoteComboChoice{mainChoice}},
+			},
+			errors: []string{QuoteErrorInvalidComboChoices},
+		},
+		{
+			name:       "tracked variant has the quantity",
+			items:      []QuoteItem{burger(15, "ketchup")},
+			errors:     []string{""},
+			linePrices: []float64{75},
+		},
+		{
+			name:   "tracked variant is short of the quantity",
+			items:  []QuoteItem{burger(16, "ketchup")},
+			errors: []string{QuoteErrorOutOfStock},
+		},
+		{
+			name:   "tracked variant is short of the quantity of all the lines",
+			items:  []QuoteItem{burger(10, "ketchup"), burger(10, "mayo")},
+			errors: []string{QuoteErrorOutOfStock, QuoteErrorOutOfStock},
+		},
+		{
+			name:   "tracked variant is short of the quantity including combo components",
+			items:  []QuoteItem{burger(14, "ketchup"), meal(2, mainChoice, sideChoice, beverageChoice)},
+			errors: []string{QuoteErrorOutOfStock, QuoteErrorOutOfStock},
+		},
+		{
+			name:       "tracked variant has the quantity including combo components",
+			items:      []QuoteItem{burger(13, "ketchup"), meal(2, mainChoice, sideChoice, beverageChoice)},
+			errors:     []string{"", ""},
+			linePrices: []float64{65, 16},
+		},
+		{
+			name:   "combo choice without variant checks the quantity of the only variant",
+			items:  []QuoteItem{meal(3, mainChoice, sideChoice, beverageChoice)},
+			errors: []string{QuoteErrorOutOfStock},
+		},
+	}
+
+	products := quoteTestProducts()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requested := requestedQuantities(test.items, products)
+			for i, item := range test.items {
+				line := quoteLine(item, "restaurant", products, requested)
+				if line.Error != test.errors[i] {
+					t.Fatalf("line %d: expected error %q, got %q", i, test.errors[i], line.Error)
+				}
+				if line.Error != "" {
+					if line.LinePrice != nil {
+						t.Errorf("line %d: expected line with error not to be priced", i)
+					}
+					continue
+				}
+				if line.LinePrice == nil || line.LinePrice.Amount != test.linePrices[i] ||
+					line.LinePrice.Currency != "USD" {
+					t.Errorf("line %d: expected line price %v USD, got %+v", i, test.linePrices[i], line.LinePrice)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+// getReservation returns the reservation of the order if the user is allowed to adjust
+// the stock of its restaurant
+func (interactor *productInteractor) getReservation(ctx context.Context, auth authentication.Auth,
+	orderID string) (product.Reservation, errors.AppError) {
+
+	reservation, repositoryError := interactor.reservationRepository.GetReservationByOrderID(ctx, orderID)
+	if repositoryError != nil {
+		return product.Reservation{}, repositoryError
+	}
+	if reservation.ID == "" {
+		return product.Reservation{}, errors.NewAppError("Unable to find reservation", http.StatusNotFound, nil)
+	}
+
+	// user should have permission to get restaurant
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx, auth,
+		reservation.RestaurantID)
+	if getRestaurantError != nil {
+		return product.Reservation{}, getRestaurantError
+	}
+
+	if !interactor.authorizer.Authorize(ctx, auth, acl.ActionAdjustStock,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+		return product.Reservation{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+	}
+	return reservation, nil
+}
+
+// restoreReservedStock returns the units of the reserved items of the reservation to the stock.
+// It should be run in the unit of work which closes the reservation, so that units are never lost
+func (interactor *productInteractor) restoreReservedStock(ctx context.Context,
+	reservation product.Reservation) errors.AppError {
+
+	productIDs := make([]string, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+	products, getProductsError := interactor.productRepository.GetProductsByIDs(ctx, productIDs)
+	if getProductsError != nil {
+		return getProductsError
+	}
+	productMap := make(map[string]product.Product, len(products))
+	for _, productObj := range products {
+		productMap[productObj.ID] = productObj
+	}
+
+	changed := map[string]bool{}
+	changedProductIDs := []string{}
+	for _, item := range reservation.Items {
+		if !item.Reserved {
+			continue
+		}
+
+		// quantity reset to the daily quantity after the reservation doesn't include its units,
+		// returning them would sell more than the daily quantity
+		variant, ok := findVariant(productMap[item.ProductID], item.VariantID)
+		if !ok || variant.StockResetOn > reservation.ReservedOn {
+			continue
+		}
+
+		variant, adjustError := interactor.productRepository.AdjustVariantQuantity(ctx, item.VariantID,
+			item.Quantity)
+		if adjustError != nil {
+			// variant which is no longer tracked by quantity has no stock to return to
+			if adjustError.StatusCode() == http.StatusConflict {
+				continue
+			}
+			return adjustError
+		}
+
+		// variant is back in stock if it had run out
+		if *variant.StockQuantity == item.Quantity && !changed[item.ProductID] {
+			changed[item.ProductID] = true
+			changedProductIDs = append(changedProductIDs, item.ProductID)
+		}
+	}
+	return interactor.refreshProductsStock(ctx, changedProductIDs)
+}
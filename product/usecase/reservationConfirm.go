@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *productInteractor) ConfirmReservation(ctx context.Context, auth authentication.Auth,
+	orderID string) (product.Reservation, errors.AppError) {
+
+	_, getReservationError := interactor.getReservation(ctx, auth, orderID)
+	if getReservationError != nil {
+		return product.Reservation{}, getReservationError
+	}
+
+	// reserved units are kept out of the stock, expired reservation can't be confirmed
+	// even if the reaper hasn't released it yet
+	return interactor.reservationRepository.CloseReservation(ctx, orderID, product.ReservationConfirmed,
+		time.Now())
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+func (interactor *productInteractor) Reserve(ctx context.Context, auth authentication.Auth,
+	request ReserveRequest) (product.Reservation, errors.AppError) {
+
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return product.Reservation{}, validationError
+	}
+
+	// user should have permission to get restaurant
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx, auth,
+		request.RestaurantID)
+	if getRestaurantError != nil {
+		return product.Reservation{}, getRestaurantError
+	}
+
+	if !interactor.authorizer.Authorize(ctx, auth, acl.ActionAdjustStock,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+		return product.Reservation{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+	}
+
+	productIDs := make([]string, 0, len(request.Items))
+	for _, item := range request.Items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+	products, getProductsError := interactor.productRepository.GetProductsByIDs(ctx, productIDs)
+	if getProductsError != nil {
+		return product.Reservation{}, getProductsError
+	}
+	productMap := make(map[string]product.Product, len(products))
+	for _, productObj := range products {
+		productMap[productObj.ID] = productObj
+	}
+
+	// only variants tracked by quantity can be reserved
+	reservedVariants := map[string]bool{}
+	for _, item := range request.Items {
+		productObj, ok := productMap[item.ProductID]
+		if !ok || productObj.RestaurantID != restaurant.ID {
+			return product.Reservation{}, errors.NewAppError("Product doesnot belong to the restaurant",
+				http.StatusBadRequest, nil)
+		}
+		variant, ok := findVariant(productObj, item.VariantID)
+		if !ok {
+			return product.Reservation{}, errors.NewAppError("Variant is not part of the provided product",
+				http.StatusBadRequest, nil)
+		}
+		if !variant.IsQuantityTracked() {
+			return product.Reservation{}, errors.NewAppError("Stock of the variant is not tracked by quantity",
+				http.StatusBadRequest, nil)
+		}
+		if reservedVariants[item.VariantID] {
+			return product.Reservation{}, errors.NewAppError("Variant can only be reserved once",
+				http.StatusBadRequest, nil)
+		}
+		reservedVariants[item.VariantID] = true
+	}
+
+	now := time.Now()
+	reservation := product.Reservation{
+		OrderID:      request.OrderID,
+		RestaurantID: restaurant.ID,
+		Items:        request.Items,
+		Status:       product.ReservationHeld,
+		ExpiresAt:    now.Add(time.Duration(request.TTLSeconds) * time.Second),
+		ReservedOn:   localDate(restaurant.TimeZone, now),
+	}
+
+	// units are taken and the reservation is stored together, reservation which fails on any
+	// of the items is rolled back without taking any units
+	reserveError := interactor.unitOfWork.Do(ctx, func(ctx context.Context) errors.AppError {
+		changed := map[string]bool{}
+		changedProductIDs := []string{}
+		for i, item := range reservation.Items {
+			variant, adjustError := interactor.productRepository.AdjustVariantQuantity(ctx, item.VariantID,
+				-item.Quantity)
+			if adjustError != nil {
+				return adjustError
+			}
+			reservation.Items[i].Reserved = true
+
+			// variant is out of stock if the reservation took its last units
+			if *variant.StockQuantity == 0 && !changed[item.ProductID] {
+				changed[item.ProductID] = true
+				changedProductIDs = append(changedProductIDs, item.ProductID)
+			}
+		}
+
+		var createError errors.AppError
+		reservation, createError = interactor.reservationRepository.CreateReservation(ctx, reservation)
+		if createError != nil {
+			return createError
+		}
+		return interactor.refreshProductsStock(ctx, changedProductIDs)
+	})
+	if reserveError != nil {
+		return product.Reservation{}, reserveError
+	}
+	return reservation, nil
+}
+
+// ReserveRequest provides the schema definition for stock reservation request. Reservation is
+// held for TTLSeconds unless it is confirmed or released
+type ReserveRequest struct {
+	OrderID      string                    `json:"order_id" validate:"required,max=64"`
+	RestaurantID string                    `json:"restaurant_id" validate:"required"`
+	Items        []product.ReservationItem `json:"items" validate:"required,min=1,max=50,dive"`
+	TTLSeconds   int64                     `json:"ttl_seconds" validate:"required,gte=30,lte=3600"`
+}
+
+// Validate validates ReserveRequest
+func (request ReserveRequest) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("validation for field '%s' failed on '%s'", err.Field(), err.Tag())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+// expireReservationsBatchSize is the max number of reservations expired in a single run
+const expireReservationsBatchSize = 100
+
+// ExpireReservations releases the held reservations which are past their expiry and returns
+// their units to the stock. It is run periodically by the server and is safe to run concurrently
+func (interactor *productInteractor) ExpireReservations(ctx context.Context) errors.AppError {
+	reservations, getReservationsError := interactor.reservationRepository.GetExpiredReservations(ctx,
+		time.Now(), expireReservationsBatchSize)
+	if getReservationsError != nil {
+		return getReservationsError
+	}
+
+	// reservation is only closed once its units are back in the stock, reservation which
+	// fails stays held and is retried in the next run without holding back the others
+	failed := 0
+	var lastError errors.AppError
+	for _, reservation := range reservations {
+		orderID := reservation.OrderID
+		expireError := interactor.unitOfWork.Do(ctx, func(ctx context.Context) errors.AppError {
+			expired, closeError := interactor.reservationRepository.CloseReservation(ctx, orderID,
+				product.ReservationExpired, time.Time{})
+			if closeError != nil {
+				return closeError
+			}
+			return interactor.restoreReservedStock(ctx, expired)
+		})
+
+		// reservation closed concurrently is already taken care of
+		if expireError != nil && expireError.StatusCode() != http.StatusConflict {
+			interactor.logger.WithContext(ctx).WithError(expireError).Error(
+				fmt.Sprintf("Unable to expire the reservation of order '%s'", orderID))
+			failed++
+			lastError = expireError
+		}
+	}
+	if failed > 0 {
+		return errors.NewAppError(fmt.Sprintf("Unable to expire %d of %d reservations", failed, len(reservations)),
+			http.StatusInternalServerError, lastError)
+	}
+	return nil
+}
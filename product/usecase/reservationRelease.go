@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *productInteractor) ReleaseReservation(ctx context.Context, auth authentication.Auth,
+	orderID string) (product.Reservation, errors.AppError) {
+
+	_, getReservationError := interactor.getReservation(ctx, auth, orderID)
+	if getReservationError != nil {
+		return product.Reservation{}, getReservationError
+	}
+
+	// reservation is only closed once its units are back in the stock
+	var reservation product.Reservation
+	releaseError := interactor.unitOfWork.Do(ctx, func(ctx context.Context) errors.AppError {
+		var closeError errors.AppError
+		reservation, closeError = interactor.reservationRepository.CloseReservation(ctx, orderID,
+			product.ReservationReleased, time.Time{})
+		if closeError != nil {
+			return closeError
+		}
+		return interactor.restoreReservedStock(ctx, reservation)
+	})
+	if releaseError != nil {
+		return product.Reservation{}, releaseError
+	}
+	return reservation, nil
+}
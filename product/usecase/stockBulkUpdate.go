@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// Bulk stock update item status
+const (
+	StockItemUpdated         = "updated"
+	StockItemNotFound        = "not_found"
+	StockItemWrongRestaurant = "wrong_restaurant"
+	StockItemQuantityTracked = "quantity_tracked"
+	StockItemFailed          = "failed"
+)
+
+func (interactor *productInteractor) BulkUpdateStock(ctx context.Context, auth authentication.Auth,
+	restaurantID string, request BulkUpdateStockRequest) (BulkUpdateStockResponse, errors.AppError) {
+
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return BulkUpdateStockResponse{}, validationError
+	}
+
+	// user should have permission to get restaurant
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx, auth, restaurantID)
+	if getRestaurantError != nil {
+		return BulkUpdateStockResponse{}, getRestaurantError
+	}
+
+	// ownership is checked once for the restaurant, items of other restaurants are reported per item
+	if !interactor.authorizer.Authorize(ctx, auth, acl.ActionUpdateStock,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+		return BulkUpdateStockResponse{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+	}
+
+	productIDs := make([]string, 0, len(request.Items))
+	for _, item := range request.Items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+	products, getProductsError := interactor.productRepository.GetProductsByIDs(ctx, productIDs)
+	if getProductsError != nil {
+		return BulkUpdateStockResponse{}, getProductsError
+	}
+	productMap := make(map[string]product.Product, len(products))
+	for _, productObj := range products {
+		productMap[productObj.ID] = productObj
+	}
+
+	results := make([]StockItemResult, len(request.Items))
+	updates := []StockItem{}
+	updateIndexes := []int{}
+	for i, item := range request.Items {
+		results[i] = StockItemResult{
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+		}
+
+		productObj, ok := productMap[item.ProductID]
+		if !ok || (item.VariantID != "" && !hasVariant(productObj, item.VariantID)) {
+			results[i].Status = StockItemNotFound
+			continue
+		}
+		if productObj.RestaurantID != restaurantID {
+			results[i].Status = StockItemWrongRestaurant
+			continue
+		}
+		if item.VariantID != "" {
+			variant, _ := findVariant(productObj, item.VariantID)
+			if variant.IsQuantityTracked() {
+				results[i].Status = StockItemQuantityTracked
+				continue
+			}
+		}
+		updates = append(updates, item)
+		updateIndexes = append(updateIndexes, i)
+	}
+
+	if len(updates) > 0 {
+		updated, repositoryError := interactor.productRepository.BulkUpdateStock(ctx, updates)
+		if repositoryError != nil {
+			return BulkUpdateStockResponse{}, repositoryError
+		}
+
+		// availability of the products whose stock changed is derived again
+		changedProductIDs := []string{}
+		changed := map[string]bool{}
+		for i, index := range updateIndexes {
+			if !updated[i] {
+				results[index].Status = StockItemFailed
+				continue
+			}
+			results[index].Status = StockItemUpdated
+
+			item := updates[i]
+			productObj := productMap[item.ProductID]
+			applyStockItem(&productObj, item)
+			productMap[item.ProductID] = productObj
+			if !changed[item.ProductID] {
+				changed[item.ProductID] = true
+				changedProductIDs = append(changedProductIDs, item.ProductID)
+			}
+		}
+
+		changedProducts := make([]product.Product, 0, len(changedProductIDs))
+		for _, productID := range changedProductIDs {
+			productObj := productMap[productID]
+			deriveError := interactor.deriveStock(ctx, &productObj)
+			if deriveError != nil {
+				return BulkUpdateStockResponse{}, deriveError
+			}
+			changedProducts = append(changedProducts, productObj)
+		}
+		syncError := interactor.productRepository.SyncProductStock(ctx, changedProducts)
+		if syncError != nil {
+			return BulkUpdateStockResponse{}, syncError
+		}
+
+		// combos of the changed products are derived once their components are stored
+		refreshError := interactor.refreshCombos(ctx, changedProductIDs)
+		if refreshError != nil {
+			return BulkUpdateStockResponse{}, refreshError
+		}
+	}
+
+	return BulkUpdateStockResponse{Results: results}, nil
+}
+
+// applyStockItem applies the stock of the item to the product or its variant
+func applyStockItem(productObj *product.Product, item StockItem) {
+	if item.VariantID == "" {
+		productObj.StockOverride = item.InStock
+		return
+	}
+	for i := range productObj.Variants {
+		if productObj.Variants[i].ID == item.VariantID {
+			productObj.Variants[i].InStock = item.InStock
+		}
+	}
+}
+
+// hasVariant checks if the variant belongs to the product
+func hasVariant(productObj product.Product, variantID string) bool {
+	for _, variant := range productObj.Variants {
+		if variant.ID == variantID {
+			return true
+		}
+	}
+	return false
+}
+
+// StockItem provides the schema definition for an item of bulk stock update request.
+// Stock of the product is updated if variant id is not provided
+type StockItem struct {
+	ProductID string `json:"product_id" validate:"required"`
+	VariantID string `json:"variant_id"`
+	InStock   *bool  `json:"in_stock" validate:"required"`
+}
+
+// BulkUpdateStockRequest provides the schema definition for bulk stock update request
+type BulkUpdateStockRequest struct {
+	Items []StockItem `json:"items" validate:"required,min=1,max=100,dive"`
+}
+
+// Validate validates BulkUpdateStockRequest
+func (request BulkUpdateStockRequest) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("validation for field '%s' failed on '%s'", err.Field(), err.Tag())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+// StockItemResult provides the schema definition for result of an item of bulk stock update
+type StockItemResult struct {
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id,omitempty"`
+	Status    string `json:"status"`
+}
+
+// BulkUpdateStockResponse provides the schema definition for bulk stock update response
+type BulkUpdateStockResponse struct {
+	Results []StockItemResult `json:"results"`
+}
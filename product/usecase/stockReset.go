@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+// ResetDailyStock resets the quantity of the variants to their daily quantity once a day in the
+// time zone of their restaurant. It is run periodically by the server and is safe to run concurrently
+func (interactor *productInteractor) ResetDailyStock(ctx context.Context) errors.AppError {
+	productIDs, resetError := interactor.productRepository.ResetDailyStock(ctx, time.Now())
+	if resetError != nil {
+		return resetError
+	}
+	return interactor.refreshProductsStock(ctx, productIDs)
+}
+
+// syncDailyStock sets the reset date of the variant with daily quantity to the current date in the
+// time zone of the restaurant when its daily quantity is set or changed, quantity provided along
+// with it is kept for the day
+func syncDailyStock(variant *product.Variant, previous product.Variant, timeZone string, now time.Time) {
+	if variant.DailyStockQuantity == nil {
+		variant.StockResetOn = ""
+		return
+	}
+	if previous.DailyStockQuantity != nil && *previous.DailyStockQuantity == *variant.DailyStockQuantity &&
+		previous.StockResetOn != "" {
+		variant.StockResetOn = previous.StockResetOn
+		return
+	}
+
+	variant.StockResetOn = localDate(timeZone, now)
+}
+
+// localDate returns the date in the time zone of the restaurant
+func localDate(timeZone string, now time.Time) string {
+	location, locationError := time.LoadLocation(timeZone)
+	if locationError != nil {
+		location = time.UTC
+	}
+	return now.In(location).Format(restaurant.DateFormat)
+}
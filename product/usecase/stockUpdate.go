@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+func (interactor *productInteractor) UpdateStock(ctx context.Context, auth authentication.Auth,
+	productID string, request UpdateStockRequest) (product.Product, errors.AppError) {
+
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return product.Product{}, validationError
+	}
+
+	productObj, getProductError := interactor.GetProductByID(ctx, auth, productID)
+	if getProductError != nil {
+		return product.Product{}, getProductError
+	}
+
+	// user should have permission to get restaurant
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx,
+		auth, productObj.RestaurantID)
+	if getRestaurantError != nil {
+		return product.Product{}, getRestaurantError
+	}
+
+	// stock can also be updated by the restaurant staff who can't edit the catalog
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionUpdateStock,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+
+		// stock of the product is stored as its override, product without a variant
+		// in stock stays out of stock
+		if request.VariantID == "" {
+			productObj.StockOverride = request.InStock
+			deriveError := interactor.deriveStock(ctx, &productObj)
+			if deriveError != nil {
+				return product.Product{}, deriveError
+			}
+
+			repositoryError := interactor.productRepository.UpdateProductStock(ctx, productID,
+				productObj.StockOverride, productObj.InStock)
+			if repositoryError != nil {
+				return product.Product{}, repositoryError
+			}
+			refreshError := interactor.refreshCombos(ctx, []string{productID})
+			return productObj, refreshError
+		}
+
+		// check if variant belong to the product
+		for i := range productObj.Variants {
+			if productObj.Variants[i].ID == request.VariantID {
+				if productObj.Variants[i].IsQuantityTracked() {
+					return product.Product{}, errors.NewAppError("Stock of the variant is tracked by its quantity",
+						http.StatusBadRequest, nil)
+				}
+
+				repositoryError := interactor.productRepository.UpdateVariantStock(ctx, request.VariantID,
+					*request.InStock)
+				if repositoryError != nil {
+					return product.Product{}, repositoryError
+				}
+				productObj.Variants[i].InStock = request.InStock
+				refreshError := interactor.refreshProductStock(ctx, &productObj)
+				return productObj, refreshError
+			}
+		}
+		return product.Product{}, errors.NewAppError("Variant is not part of the provided product",
+			http.StatusBadRequest, nil)
+	}
+	return product.Product{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
+
+// refreshProductStock derives the availability of the product after its variants are changed
+// and stores it along with the availability of the combos it is part of
+func (interactor *productInteractor) refreshProductStock(ctx context.Context,
+	productObj *product.Product) errors.AppError {
+
+	deriveError := interactor.deriveStock(ctx, productObj)
+	if deriveError != nil {
+		return deriveError
+	}
+
+	syncError := interactor.productRepository.SyncProductStock(ctx, []product.Product{*productObj})
+	if syncError != nil {
+		return syncError
+	}
+	return interactor.refreshCombos(ctx, []string{productObj.ID})
+}
+
+// refreshProductsStock derives and stores the availability of the provided products, whose variants
+// were changed in the datastore, along with the availability of the combos they are part of
+func (interactor *productInteractor) refreshProductsStock(ctx context.Context, productIDs []string) errors.AppError {
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	products, getProductsError := interactor.productRepository.GetProductsByIDs(ctx, productIDs)
+	if getProductsError != nil {
+		return getProductsError
+	}
+	for i := range products {
+		deriveError := interactor.deriveStock(ctx, &products[i])
+		if deriveError != nil {
+			return deriveError
+		}
+	}
+	syncError := interactor.productRepository.SyncProductStock(ctx, products)
+	if syncError != nil {
+		return syncError
+	}
+	return interactor.refreshCombos(ctx, productIDs)
+}
+
+// deriveStock derives the availability of the product, availability of the combo
+// also depends on its components
+func (interactor *productInteractor) deriveStock(ctx context.Context, productObj *product.Product) errors.AppError {
+	if !productObj.IsCombo() {
+		productObj.RefreshStock()
+		return nil
+	}
+
+	components, getComponentsError := interactor.getComponents(ctx, []product.Product{*productObj})
+	if getComponentsError != nil {
+		return getComponentsError
+	}
+	productObj.RefreshComboStock(components)
+	return nil
+}
+
+// refreshCombos derives and stores the availability of the combos which have any of
+// the provided products as component
+func (interactor *productInteractor) refreshCombos(ctx context.Context, productIDs []string) errors.AppError {
+	combos, getCombosError := interactor.productRepository.GetCombosByComponentIDs(ctx, productIDs)
+	if getCombosError != nil || len(combos) == 0 {
+		return getCombosError
+	}
+
+	components, getComponentsError := interactor.getComponents(ctx, combos)
+	if getComponentsError != nil {
+		return getComponentsError
+	}
+	for i := range combos {
+		combos[i].RefreshComboStock(components)
+	}
+	return interactor.productRepository.SyncProductStock(ctx, combos)
+}
+
+// UpdateStockRequest provides the schema definition for product stock update request.
+// Stock of the product is updated if variant id is not provided
+type UpdateStockRequest struct {
+	VariantID string `json:"variant_id"`
+	InStock   *bool  `json:"in_stock" validate:"required"`
+}
+
+// Validate validates UpdateStockRequest
+func (request UpdateStockRequest) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("validation for field '%s' failed on '%s'", err.Field(), err.Tag())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
 	categoryUsecase "github.com/dhyaniarun1993/foody-catalog-service/category/usecase"
@@ -13,13 +15,39 @@ import (
 	"gopkg.in/go-playground/validator.v9"
 )
 
+type unitOfWork interface {
+	Do(ctx context.Context, work func(ctx context.Context) errors.AppError) errors.AppError
+}
+
 type productRepository interface {
 	CreateProduct(ctx context.Context, product product.Product) (product.Product, errors.AppError)
 	CreateVariant(ctx context.Context, variant product.Variant) (product.Variant, errors.AppError)
+	UpdateProduct(ctx context.Context, product product.Product) (product.Product, errors.AppError)
+	UpdateVariant(ctx context.Context, variant product.Variant) (product.Variant, errors.AppError)
 	GetProductByID(ctx context.Context, productID string) (product.Product, errors.AppError)
 	GetVariantByID(ctx context.Context, variantID string) (product.Variant, errors.AppError)
+	GetProductsByIDs(ctx context.Context, productIDs []string) ([]product.Product, errors.AppError)
+	GetCombosByComponentIDs(ctx context.Context, productIDs []string) ([]product.Product, errors.AppError)
+	GetAllProducts(ctx context.Context, request GetAllProductsRequest) ([]product.Product, errors.AppError)
+	GetAllProductsTotalCount(ctx context.Context, request GetAllProductsRequest) (int64, errors.AppError)
 	DeleteProductByID(ctx context.Context, productID string) errors.AppError
-	DeleteVariantByID(ctx context.Context, variantID string) errors.AppError
+	DeleteVariantByID(ctx context.Context, productID string, variantID string) errors.AppError
+	UpdateProductStock(ctx context.Context, productID string, stockOverride *bool, inStock bool) errors.AppError
+	UpdateVariantStock(ctx context.Context, variantID string, inStock bool) errors.AppError
+	SetVariantQuantity(ctx context.Context, variantID string, quantity int64) errors.AppError
+	AdjustVariantQuantity(ctx context.Context, variantID string, delta int64) (product.Variant, errors.AppError)
+	ResetDailyStock(ctx context.Context, now time.Time) ([]string, errors.AppError)
+	BulkUpdateStock(ctx context.Context, items []StockItem) ([]bool, errors.AppError)
+	SyncProductStock(ctx context.Context, products []product.Product) errors.AppError
+}
+
+type reservationRepository interface {
+	CreateReservation(ctx context.Context, reservation product.Reservation) (product.Reservation, errors.AppError)
+	GetReservationByOrderID(ctx context.Context, orderID string) (product.Reservation, errors.AppError)
+	GetExpiredReservations(ctx context.Context, now time.Time, limit int64) ([]product.Reservation, errors.AppError)
+	CloseReservation(ctx context.Context, orderID string, status string,
+		heldUntil time.Time) (product.Reservation, errors.AppError)
+	DeleteReservationByID(ctx context.Context, reservationID string) errors.AppError
 }
 
 // Interactor provides interface for product interactor
@@ -28,30 +56,57 @@ type Interactor interface {
 	AddVariant(ctx context.Context, auth authentication.Auth,
 		productID string, variant product.Variant) (product.Variant, errors.AppError)
 	GetProductByID(ctx context.Context, auth authentication.Auth, productID string) (product.Product, errors.AppError)
+	BatchGetProducts(ctx context.Context, auth authentication.Auth,
+		request BatchGetProductsRequest) (BatchGetProductsResponse, errors.AppError)
+	GetAllProducts(ctx context.Context, auth authentication.Auth,
+		request GetAllProductsRequest) (GetAllProductsResponse, errors.AppError)
+	UpdateProduct(ctx context.Context, auth authentication.Auth, productID string,
+		patch json.RawMessage) (product.Product, errors.AppError)
 	DeleteProductByID(ctx context.Context, auth authentication.Auth, productID string) errors.AppError
+	UpdateVariant(ctx context.Context, auth authentication.Auth, productID string, variantID string,
+		patch json.RawMessage) (product.Variant, errors.AppError)
 	RemoveVariant(ctx context.Context, auth authentication.Auth, productID string,
 		variantID string) errors.AppError
+	UpdateStock(ctx context.Context, auth authentication.Auth, productID string,
+		request UpdateStockRequest) (product.Product, errors.AppError)
+	BulkUpdateStock(ctx context.Context, auth authentication.Auth, restaurantID string,
+		request BulkUpdateStockRequest) (BulkUpdateStockResponse, errors.AppError)
+	AdjustQuantity(ctx context.Context, auth authentication.Auth, productID string, variantID string,
+		request AdjustQuantityRequest) (product.Variant, errors.AppError)
+	ResetDailyStock(ctx context.Context) errors.AppError
+	Reserve(ctx context.Context, auth authentication.Auth, request ReserveRequest) (product.Reservation, errors.AppError)
+	ConfirmReservation(ctx context.Context, auth authentication.Auth,
+		orderID string) (product.Reservation, errors.AppError)
+	ReleaseReservation(ctx context.Context, auth authentication.Auth,
+		orderID string) (product.Reservation, errors.AppError)
+	ExpireReservations(ctx context.Context) errors.AppError
+	Quote(ctx context.Context, auth authentication.Auth, request QuoteRequest) (QuoteResponse, errors.AppError)
 }
 
 type productInteractor struct {
-	productRepository    productRepository
-	restaurantInteractor restaurantUsecase.Interactor
-	categoryInteractor   categoryUsecase.Interactor
-	logger               *logger.Logger
-	rbac                 acl.RBAC
-	validator            *validator.Validate
+	productRepository     productRepository
+	reservationRepository reservationRepository
+	unitOfWork            unitOfWork
+	restaurantInteractor  restaurantUsecase.Interactor
+	categoryInteractor    categoryUsecase.Interactor
+	logger                *logger.Logger
+	authorizer            acl.Authorizer
+	validator             *validator.Validate
 }
 
 // NewProductInteractor creates and return product Interactor
-func NewProductInteractor(productRepository productRepository, restaurantInteractor restaurantUsecase.Interactor,
-	categoryInteractor categoryUsecase.Interactor, logger *logger.Logger, rbac acl.RBAC,
+func NewProductInteractor(productRepository productRepository, reservationRepository reservationRepository,
+	unitOfWork unitOfWork, restaurantInteractor restaurantUsecase.Interactor,
+	categoryInteractor categoryUsecase.Interactor, logger *logger.Logger, authorizer acl.Authorizer,
 	validator *validator.Validate) Interactor {
 	return &productInteractor{
-		productRepository:    productRepository,
-		restaurantInteractor: restaurantInteractor,
-		categoryInteractor:   categoryInteractor,
-		logger:               logger,
-		rbac:                 rbac,
-		validator:            validator,
+		productRepository:     productRepository,
+		reservationRepository: reservationRepository,
+		unitOfWork:            unitOfWork,
+		restaurantInteractor:  restaurantInteractor,
+		categoryInteractor:    categoryInteractor,
+		logger:                logger,
+		authorizer:            authorizer,
+		validator:             validator,
 	}
 }
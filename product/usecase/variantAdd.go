@@ -3,7 +3,9 @@ package usecase
 import (
 	"context"
 	"net/http"
+	"time"
 
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
 	"github.com/dhyaniarun1993/foody-common/authentication"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
@@ -15,6 +17,7 @@ func (interactor *productInteractor) AddVariant(ctx context.Context, auth authen
 	productID string, variant product.Variant) (product.Variant, errors.AppError) {
 
 	variant.ProductID = productID
+	variant.SyncQuantity()
 	// validate product schema
 	validationError := variant.Validate(interactor.validator)
 	if validationError != nil {
@@ -36,13 +39,20 @@ func (interactor *productInteractor) AddVariant(ctx context.Context, auth authen
 	}
 
 	// check if user have permission to add variant to product
-	if (restaurant.MerchantID == auth.GetUserID() &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteOwn)) ||
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteAny) {
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite,
+		restaurantUsecase.Resource(restaurant)).Allowed {
+
+		syncDailyStock(&variant, product.Variant{}, restaurant.TimeZone, time.Now())
 
 		var createVariantError errors.AppError
 		variant, createVariantError = interactor.productRepository.CreateVariant(ctx, variant)
-		return variant, createVariantError
+		if createVariantError != nil {
+			return product.Variant{}, createVariantError
+		}
+
+		productObj.Variants = append(productObj.Variants, variant)
+		refreshError := interactor.refreshProductStock(ctx, &productObj)
+		return variant, refreshError
 	}
 	return product.Variant{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
 }
@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
 	"github.com/dhyaniarun1993/foody-common/authentication"
 	"github.com/dhyaniarun1993/foody-common/errors"
 )
@@ -27,9 +29,8 @@ func (interactor *productInteractor) RemoveVariant(ctx context.Context, auth aut
 	}
 
 	// check if user have permission to remove variant from product
-	if (restaurant.MerchantID == auth.GetUserID() &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteOwn)) ||
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteAny) {
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite,
+		restaurantUsecase.Resource(restaurant)).Allowed {
 
 		variant, getVariantError := interactor.productRepository.GetVariantByID(ctx, variantID)
 		if getVariantError != nil {
@@ -41,10 +42,44 @@ func (interactor *productInteractor) RemoveVariant(ctx context.Context, auth aut
 			return errors.NewAppError("Variant is not part of the provided product", http.StatusBadRequest, nil)
 		}
 
-		// delete variant
-		var deleteVariantError errors.AppError
-		deleteVariantError = interactor.productRepository.DeleteVariantByID(ctx, variantID)
-		return deleteVariantError
+		// product should always have at least one variant
+		if len(productObj.Variants) <= 1 {
+			return errors.NewAppError("Product should have at least one variant", http.StatusConflict, nil)
+		}
+
+		// variant can't be removed while it is chosen by any combo
+		combos, getCombosError := interactor.productRepository.GetCombosByComponentIDs(ctx,
+			[]string{productObj.ID})
+		if getCombosError != nil {
+			return getCombosError
+		}
+		for _, combo := range combos {
+			for _, slot := range combo.Slots {
+				for _, choice := range slot.Choices {
+					if choice.VariantID == variantID {
+						return errors.NewAppError("Variant is part of combos, remove it from combos first",
+							http.StatusConflict, nil)
+					}
+				}
+			}
+		}
+
+		// delete variant, deletion is rejected if concurrent removal left the product without variants
+		deleteVariantError := interactor.unitOfWork.Do(ctx, func(ctx context.Context) errors.AppError {
+			return interactor.productRepository.DeleteVariantByID(ctx, productObj.ID, variantID)
+		})
+		if deleteVariantError != nil {
+			return deleteVariantError
+		}
+
+		variants := []product.Variant{}
+		for _, productVariant := range productObj.Variants {
+			if productVariant.ID != variantID {
+				variants = append(variants, productVariant)
+			}
+		}
+		productObj.Variants = variants
+		return interactor.refreshProductStock(ctx, &productObj)
 	}
 	return errors.NewAppError("Forbidden", http.StatusForbidden, nil)
 }
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/mergepatch"
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *productInteractor) UpdateVariant(ctx context.Context, auth authentication.Auth,
+	productID string, variantID string, patch json.RawMessage) (product.Variant, errors.AppError) {
+
+	// check if product exist
+	productObj, getProductError := interactor.GetProductByID(ctx, auth, productID)
+	if getProductError != nil {
+		return product.Variant{}, getProductError
+	}
+
+	// user should have permission to get the restaurant
+	restaurant, getRestaurantError := interactor.restaurantInteractor.GetByID(ctx, auth,
+		productObj.RestaurantID)
+	if getRestaurantError != nil {
+		return product.Variant{}, getRestaurantError
+	}
+
+	variant, getVariantError := interactor.productRepository.GetVariantByID(ctx, variantID)
+	if getVariantError != nil {
+		return product.Variant{}, getVariantError
+	}
+
+	// check if variant belong to the product
+	if variant.ProductID != productObj.ID {
+		return product.Variant{}, errors.NewAppError("Variant is not part of the provided product",
+			http.StatusBadRequest, nil)
+	}
+
+	updatedVariant := variant
+	patchError := mergepatch.Apply(&updatedVariant, patch)
+	if patchError != nil {
+		return product.Variant{}, patchError
+	}
+
+	// fields managed by the service cannot be patched
+	updatedVariant.ID = variant.ID
+	updatedVariant.ProductID = variant.ProductID
+	updatedVariant.CreatedAt = variant.CreatedAt
+	updatedVariant.UpdatedAt = variant.UpdatedAt
+	updatedVariant.StockResetOn = variant.StockResetOn
+	updatedVariant.SyncQuantity()
+
+	validationError := updatedVariant.Validate(interactor.validator)
+	if validationError != nil {
+		return product.Variant{}, validationError
+	}
+
+	syncDailyStock(&updatedVariant, variant, restaurant.TimeZone, time.Now())
+
+	// staff allowed to update the stock can update the variant if only its stock is changed
+	action := acl.ActionWrite
+	stockUpdate := variant
+	stockUpdate.InStock = updatedVariant.InStock
+	stockUpdate.StockQuantity = updatedVariant.StockQuantity
+	stockUpdate.DailyStockQuantity = updatedVariant.DailyStockQuantity
+	stockUpdate.StockResetOn = updatedVariant.StockResetOn
+	if reflect.DeepEqual(stockUpdate, updatedVariant) {
+		action = acl.ActionUpdateStock
+	}
+
+	// check if user have permission to update variant of the product
+	if interactor.authorizer.Authorize(ctx, auth, action, restaurantUsecase.Resource(restaurant)).Allowed {
+		var repositoryError errors.AppError
+		updatedVariant, repositoryError = interactor.productRepository.UpdateVariant(ctx, updatedVariant)
+		if repositoryError != nil {
+			return product.Variant{}, repositoryError
+		}
+
+		// quantity is only written when changed, so that concurrent adjustments are kept
+		if updatedVariant.IsQuantityTracked() && !reflect.DeepEqual(updatedVariant.StockQuantity,
+			variant.StockQuantity) {
+			repositoryError = interactor.productRepository.SetVariantQuantity(ctx, updatedVariant.ID,
+				*updatedVariant.StockQuantity)
+			if repositoryError != nil {
+				return product.Variant{}, repositoryError
+			}
+		}
+
+		for i := range productObj.Variants {
+			if productObj.Variants[i].ID == updatedVariant.ID {
+				productObj.Variants[i] = updatedVariant
+			}
+		}
+		refreshError := interactor.refreshProductStock(ctx, &productObj)
+		return updatedVariant, refreshError
+	}
+	return product.Variant{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
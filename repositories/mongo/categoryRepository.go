@@ -12,6 +12,8 @@ import (
 	"github.com/dhyaniarun1993/foody-common/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongoDriver "go.mongodb.org/mongo-driver/mongo"
+	mongoOptions "go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const (
@@ -40,11 +42,38 @@ func (db *categoryRepository) Create(ctx context.Context,
 		return category, daoErr
 	}
 
-	insertCtx, insertCancel := context.WithTimeout(ctx, 1*time.Second)
-	defer insertCancel()
+	lastCtx, lastCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer lastCancel()
 
 	collection := db.Database(db.database).Collection(categoryCollection)
 
+	// new category is displayed after the last category of the restaurant, positions of
+	// the deleted categories are not reused
+	lastFilter := bson.D{
+		{
+			Key:   "restaurant_id",
+			Value: categoryDao.RestaurantID,
+		},
+	}
+	lastOptions := mongoOptions.FindOne().
+		SetSort(bson.D{{Key: "position", Value: -1}}).
+		SetProjection(bson.D{{Key: "position", Value: 1}})
+	var last dao.CategoryDao
+	lastError := collection.FindOne(lastCtx, lastFilter, lastOptions).Decode(&last)
+	if lastError != nil && lastError != mongoDriver.ErrNoDocuments {
+		return category, errors.NewAppError("Something went wrong",
+			http.StatusServiceUnavailable, lastError)
+	}
+	position := int64(0)
+	if lastError == nil {
+		position = last.Position + 1
+	}
+	categoryDao.Position = position
+	category.Position = position
+
+	insertCtx, insertCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer insertCancel()
+
 	insertResult, insertError := collection.InsertOne(insertCtx, categoryDao)
 	if insertError != nil {
 		err := errors.NewAppError("Something went wrong",
@@ -75,8 +104,7 @@ func (db *categoryRepository) GetByID(ctx context.Context, categoryID string) (c
 
 	cursor, findError := collection.Find(findCtx, filter)
 	if findError != nil {
-		return category.Category{}, errors.NewAppError("Something went wrong",
-			http.StatusInternalServerError, findError)
+		return category.Category{}, operationError(findError)
 	}
 
 	cursorCtx, cursorCancel := context.WithCancel(ctx)
@@ -85,13 +113,159 @@ func (db *categoryRepository) GetByID(ctx context.Context, categoryID string) (c
 	if cursor.Next(cursorCtx) {
 		decodeError := cursor.Decode(&categoryObj)
 		if decodeError != nil {
-			return category.Category{}, errors.NewAppError("Something went wrong",
-				http.StatusInternalServerError, decodeError)
+			return category.Category{}, operationError(decodeError)
 		}
 	}
 	return categoryObj, nil
 }
 
+func (db *categoryRepository) Update(ctx context.Context,
+	categoryObj category.Category) (category.Category, errors.AppError) {
+
+	categoryObj.UpdatedAt = time.Now()
+
+	categoryDao, daoErr := dao.GetCategoryDao(categoryObj)
+	if daoErr != nil {
+		return categoryObj, daoErr
+	}
+
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	// restaurant and position of the category are not updated
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: categoryDao.ID,
+		},
+	}
+	set := bson.D{
+		{Key: "name", Value: categoryDao.Name},
+		{Key: "description", Value: categoryDao.Description},
+		{Key: "updated_at", Value: categoryDao.UpdatedAt},
+	}
+	update := bson.D{}
+	if categoryDao.ParentCategoryID != nil {
+		set = append(set, bson.E{Key: "parent_category_id", Value: categoryDao.ParentCategoryID})
+	} else {
+		// category is moved to the top level
+		update = append(update, bson.E{
+			Key: "$unset",
+			Value: bson.D{
+				{Key: "parent_category_id", Value: ""},
+			},
+		})
+	}
+	update = append(update, bson.E{Key: "$set", Value: set})
+
+	collection := db.Database(db.database).Collection(categoryCollection)
+
+	_, updateError := collection.UpdateOne(updateCtx, filter, update)
+	if updateError != nil {
+		return categoryObj, operationError(updateError)
+	}
+	return categoryObj, nil
+}
+
+func (db *categoryRepository) GetByRestaurantID(ctx context.Context,
+	restaurantID string) ([]category.Category, errors.AppError) {
+
+	categories := []category.Category{}
+	findCtx, findCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer findCancel()
+
+	restaurantObjectID, _ := primitive.ObjectIDFromHex(restaurantID)
+	filter := bson.D{
+		{
+			Key:   "restaurant_id",
+			Value: restaurantObjectID,
+		},
+	}
+	findOptions := &mongoOptions.FindOptions{
+		Sort: bson.D{
+			{Key: "position", Value: 1},
+			{Key: "created_at", Value: 1},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(categoryCollection)
+
+	cursor, findError := collection.Find(findCtx, filter, findOptions)
+	if findError != nil {
+		return categories, operationError(findError)
+	}
+
+	cursorCtx, cursorCancel := context.WithCancel(ctx)
+	defer cursorCancel()
+	for cursor.Next(cursorCtx) {
+		var categoryObj category.Category
+		decodeError := cursor.Decode(&categoryObj)
+		if decodeError != nil {
+			return categories, operationError(decodeError)
+		}
+		categories = append(categories, categoryObj)
+	}
+	return categories, nil
+}
+
+func (db *categoryRepository) Reorder(ctx context.Context, restaurantID string,
+	categoryIDs []string) errors.AppError {
+
+	restaurantObjectID, _ := primitive.ObjectIDFromHex(restaurantID)
+	updates := make([]mongoDriver.WriteModel, len(categoryIDs))
+	for i, categoryID := range categoryIDs {
+		categoryObjectID, _ := primitive.ObjectIDFromHex(categoryID)
+		filter := bson.D{
+			{Key: "_id", Value: categoryObjectID},
+			{Key: "restaurant_id", Value: restaurantObjectID},
+		}
+		update := bson.D{
+			{
+				Key: "$set",
+				Value: bson.D{
+					{Key: "position", Value: i},
+					{Key: "updated_at", Value: time.Now()},
+				},
+			},
+		}
+		updates[i] = mongoDriver.NewUpdateOneModel().SetFilter(filter).SetUpdate(update)
+	}
+
+	reorderCtx, reorderCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer reorderCancel()
+
+	collection := db.Database(db.database).Collection(categoryCollection)
+
+	// positions of all the categories are updated in a transaction, so menu is
+	// never displayed in partially updated order
+	var reorderError errors.AppError
+	sessionError := db.UseSession(reorderCtx, func(sessionCtx mongoDriver.SessionContext) error {
+		transactionError := sessionCtx.StartTransaction()
+		if transactionError != nil {
+			return transactionError
+		}
+
+		result, updateError := collection.BulkWrite(sessionCtx, updates)
+		if updateError != nil {
+			sessionCtx.AbortTransaction(sessionCtx)
+			return updateError
+		}
+
+		// category is deleted or moved since the order was validated
+		if result.MatchedCount != int64(len(categoryIDs)) {
+			sessionCtx.AbortTransaction(sessionCtx)
+			reorderError = errors.NewAppError("Categories of the restaurant have been changed, please retry",
+				http.StatusConflict, nil)
+			return nil
+		}
+		return sessionCtx.CommitTransaction(sessionCtx)
+	})
+	if sessionError != nil {
+		return operationError(sessionError)
+	}
+	return reorderError
+}
+
 func (db *categoryRepository) DeleteByID(ctx context.Context, categoryID string) errors.AppError {
 
 	deleteCtx, deleteCancel := context.WithTimeout(ctx, 1*time.Second)
@@ -109,7 +283,7 @@ func (db *categoryRepository) DeleteByID(ctx context.Context, categoryID string)
 
 	_, deleteErr := collection.DeleteOne(deleteCtx, filter)
 	if deleteErr != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, deleteErr)
+		return operationError(deleteErr)
 	}
 	return nil
 }
@@ -131,7 +305,101 @@ func (db *categoryRepository) DeleteByRestaurantID(ctx context.Context, restaura
 
 	_, deleteErr := collection.DeleteMany(deleteCtx, filter)
 	if deleteErr != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, deleteErr)
+		return operationError(deleteErr)
 	}
 	return nil
 }
+
+func (db *categoryRepository) GetMenuByRestaurantID(ctx context.Context,
+	restaurantID string) ([]category.Category, errors.AppError) {
+
+	categories := []category.Category{}
+	aggregateCtx, aggregateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer aggregateCancel()
+
+	restaurantObjectID, _ := primitive.ObjectIDFromHex(restaurantID)
+	match := bson.D{
+		{
+			Key: "$match",
+			Value: bson.D{
+				{Key: "restaurant_id", Value: restaurantObjectID},
+			},
+		},
+	}
+	sort := bson.D{
+		{
+			Key: "$sort",
+			Value: bson.D{
+				{Key: "position", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+		},
+	}
+	// lookup products of each category along with their variants
+	lookupProducts := bson.D{
+		{
+			Key: "$lookup",
+			Value: bson.D{
+				{Key: "from", Value: productCollection},
+				{Key: "let", Value: bson.D{{Key: "category_id", Value: "$_id"}}},
+				{
+					Key: "pipeline",
+					Value: mongoDriver.Pipeline{
+						{
+							{
+								Key: "$match",
+								Value: bson.D{
+									{
+										Key: "$expr",
+										Value: bson.D{
+											{Key: "$eq", Value: bson.A{"$category_id", "$$category_id"}},
+										},
+									},
+								},
+							},
+						},
+						{
+							{
+								Key: "$sort",
+								Value: bson.D{
+									{Key: "created_at", Value: 1},
+								},
+							},
+						},
+						{
+							{
+								Key: "$lookup",
+								Value: bson.D{
+									{Key: "localField", Value: "_id"},
+									{Key: "from", Value: variantCollection},
+									{Key: "foreignField", Value: "product_id"},
+									{Key: "as", Value: "variants"},
+								},
+							},
+						},
+					},
+				},
+				{Key: "as", Value: "products"},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(categoryCollection)
+	cursor, aggregateError := collection.Aggregate(aggregateCtx,
+		mongoDriver.Pipeline{match, sort, lookupProducts})
+	if aggregateError != nil {
+		return categories, operationError(aggregateError)
+	}
+
+	cursorCtx, cursorCancel := context.WithCancel(ctx)
+	defer cursorCancel()
+	for cursor.Next(cursorCtx) {
+		var categoryObj category.Category
+		decodeError := cursor.Decode(&categoryObj)
+		if decodeError != nil {
+			return categories, operationError(decodeError)
+		}
+		categories = append(categories, categoryObj)
+	}
+	return categories, nil
+}
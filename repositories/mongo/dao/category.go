@@ -11,12 +11,14 @@ import (
 
 // CategoryDao provides the model definition for category data to be stored in mongodb
 type CategoryDao struct {
-	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	RestaurantID primitive.ObjectID `bson:"restaurant_id,omitempty" json:"restaurant_id"`
-	Name         string             `bson:"name" json:"name"`
-	Description  string             `bson:"description" json:"description"`
-	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt    time.Time          `bson:"updated_at" json:"updated_at"`
+	ID               primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	RestaurantID     primitive.ObjectID  `bson:"restaurant_id,omitempty" json:"restaurant_id"`
+	ParentCategoryID *primitive.ObjectID `bson:"parent_category_id,omitempty" json:"parent_category_id"`
+	Name             string              `bson:"name" json:"name"`
+	Description      string              `bson:"description" json:"description"`
+	Position         int64               `bson:"position" json:"position"`
+	CreatedAt        time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt        time.Time           `bson:"updated_at" json:"updated_at"`
 }
 
 // GetCategoryDao converts and returns category Dao object from category schema
@@ -24,6 +26,7 @@ func GetCategoryDao(category category.Category) (CategoryDao, errors.AppError) {
 	categoryDao := CategoryDao{
 		Name:        category.Name,
 		Description: category.Description,
+		Position:    category.Position,
 		CreatedAt:   category.CreatedAt,
 		UpdatedAt:   category.UpdatedAt,
 	}
@@ -43,5 +46,14 @@ func GetCategoryDao(category category.Category) (CategoryDao, errors.AppError) {
 	}
 	categoryDao.RestaurantID = restaurantObjectID
 
+	// parent category is optional
+	if category.ParentCategoryID != "" {
+		parentObjectID, err := primitive.ObjectIDFromHex(category.ParentCategoryID)
+		if err != nil {
+			return CategoryDao{}, errors.NewAppError("Something went wrong", http.StatusInternalServerError, err)
+		}
+		categoryDao.ParentCategoryID = &parentObjectID
+	}
+
 	return categoryDao, nil
 }
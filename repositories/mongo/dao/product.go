@@ -18,14 +18,17 @@ type PriceDao struct {
 
 // VariantDao provides the schema definition for Products Variant data to be stored in mongodb
 type VariantDao struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	ProductID   primitive.ObjectID `bson:"product_id" json:"product_id"`
-	Name        string             `bson:"name" json:"name"`
-	Description string             `bson:"description" json:"description"`
-	Price       PriceDao           `bson:"price" json:"price"`
-	InStock     *bool              `bson:"in_stock"  json:"in_stock"`
-	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+	ID                 primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	ProductID          primitive.ObjectID `bson:"product_id" json:"product_id"`
+	Name               string             `bson:"name" json:"name"`
+	Description        string             `bson:"description" json:"description"`
+	Price              PriceDao           `bson:"price" json:"price"`
+	InStock            *bool              `bson:"in_stock"  json:"in_stock"`
+	StockQuantity      *int64             `bson:"stock_quantity,omitempty" json:"stock_quantity,omitempty"`
+	DailyStockQuantity *int64             `bson:"daily_stock_quantity,omitempty" json:"daily_stock_quantity,omitempty"`
+	StockResetOn       string             `bson:"stock_reset_on,omitempty" json:"stock_reset_on,omitempty"`
+	CreatedAt          time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt          time.Time          `bson:"updated_at" json:"updated_at"`
 }
 
 // GetVariantDao converts and returns product variant Dao object from product variant schema
@@ -37,9 +40,12 @@ func GetVariantDao(variant product.Variant) (VariantDao, errors.AppError) {
 			Amount:   variant.Price.Amount,
 			Currency: variant.Price.Currency,
 		},
-		InStock:   variant.InStock,
-		CreatedAt: variant.CreatedAt,
-		UpdatedAt: variant.UpdatedAt,
+		InStock:            variant.InStock,
+		StockQuantity:      variant.StockQuantity,
+		DailyStockQuantity: variant.DailyStockQuantity,
+		StockResetOn:       variant.StockResetOn,
+		CreatedAt:          variant.CreatedAt,
+		UpdatedAt:          variant.UpdatedAt,
 	}
 
 	if variant.ID != "" {
@@ -60,28 +66,152 @@ func GetVariantDao(variant product.Variant) (VariantDao, errors.AppError) {
 	return variantDao, nil
 }
 
+// ModifierOptionDao provides the schema definition for option of the modifier group
+// to be stored in mongodb
+type ModifierOptionDao struct {
+	ID      primitive.ObjectID `bson:"_id" json:"id"`
+	Name    string             `bson:"name" json:"name"`
+	Price   PriceDao           `bson:"price" json:"price"`
+	InStock *bool              `bson:"in_stock" json:"in_stock"`
+}
+
+// ModifierGroupDao provides the schema definition for modifier group of the product
+// to be stored in mongodb
+type ModifierGroupDao struct {
+	ID            primitive.ObjectID  `bson:"_id" json:"id"`
+	Name          string              `bson:"name" json:"name"`
+	MinSelections int                 `bson:"min_selections" json:"min_selections"`
+	MaxSelections int                 `bson:"max_selections" json:"max_selections"`
+	Options       []ModifierOptionDao `bson:"options" json:"options"`
+}
+
+// GetModifierGroupDao converts and returns modifier group Dao object from modifier group schema
+func GetModifierGroupDao(group product.ModifierGroup) (ModifierGroupDao, errors.AppError) {
+	groupObjectID, err := primitive.ObjectIDFromHex(group.ID)
+	if err != nil {
+		return ModifierGroupDao{}, errors.NewAppError("Something went wrong", http.StatusInternalServerError, err)
+	}
+
+	groupDao := ModifierGroupDao{
+		ID:            groupObjectID,
+		Name:          group.Name,
+		MinSelections: group.MinSelections,
+		MaxSelections: group.MaxSelections,
+		Options:       make([]ModifierOptionDao, 0, len(group.Options)),
+	}
+
+	for _, option := range group.Options {
+		optionObjectID, err := primitive.ObjectIDFromHex(option.ID)
+		if err != nil {
+			return ModifierGroupDao{}, errors.NewAppError("Something went wrong", http.StatusInternalServerError, err)
+		}
+		groupDao.Options = append(groupDao.Options, ModifierOptionDao{
+			ID:   optionObjectID,
+			Name: option.Name,
+			Price: PriceDao{
+				Amount:   option.Price.Amount,
+				Currency: option.Price.Currency,
+			},
+			InStock: option.InStock,
+		})
+	}
+	return groupDao, nil
+}
+
+// ComboChoiceDao provides the schema definition for choice of the combo slot to be stored in mongodb
+type ComboChoiceDao struct {
+	ProductID primitive.ObjectID  `bson:"product_id" json:"product_id"`
+	VariantID *primitive.ObjectID `bson:"variant_id,omitempty" json:"variant_id,omitempty"`
+}
+
+// ComboSlotDao provides the schema definition for slot of the combo to be stored in mongodb
+type ComboSlotDao struct {
+	ID      primitive.ObjectID `bson:"_id" json:"id"`
+	Name    string             `bson:"name" json:"name"`
+	Choices []ComboChoiceDao   `bson:"choices" json:"choices"`
+}
+
+// GetComboSlotDao converts and returns combo slot Dao object from combo slot schema
+func GetComboSlotDao(slot product.ComboSlot) (ComboSlotDao, errors.AppError) {
+	slotObjectID, err := primitive.ObjectIDFromHex(slot.ID)
+	if err != nil {
+		return ComboSlotDao{}, errors.NewAppError("Something went wrong", http.StatusInternalServerError, err)
+	}
+
+	slotDao := ComboSlotDao{
+		ID:      slotObjectID,
+		Name:    slot.Name,
+		Choices: make([]ComboChoiceDao, 0, len(slot.Choices)),
+	}
+
+	for _, choice := range slot.Choices {
+		productObjectID, err := primitive.ObjectIDFromHex(choice.ProductID)
+		if err != nil {
+			return ComboSlotDao{}, errors.NewAppError("Something went wrong", http.StatusInternalServerError, err)
+		}
+		choiceDao := ComboChoiceDao{
+			ProductID: productObjectID,
+		}
+
+		// variant id is optional
+		if choice.VariantID != "" {
+			variantObjectID, err := primitive.ObjectIDFromHex(choice.VariantID)
+			if err != nil {
+				return ComboSlotDao{}, errors.NewAppError("Something went wrong",
+					http.StatusInternalServerError, err)
+			}
+			choiceDao.VariantID = &variantObjectID
+		}
+		slotDao.Choices = append(slotDao.Choices, choiceDao)
+	}
+	return slotDao, nil
+}
+
 // ProductDao provides the model definition for product data to be stored in mongodb
 type ProductDao struct {
-	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	RestaurantID primitive.ObjectID `bson:"restaurant_id" json:"restaurant_id"`
-	CategoryID   primitive.ObjectID `bson:"category_id" json:"category_id"`
-	Name         string             `bson:"name" json:"name"`
-	Description  string             `bson:"description" json:"description"`
-	IsVeg        bool               `bson:"is_veg" json:"is_veg"`
-	InStock      bool               `bson:"in_stock"  json:"in_stock"`
-	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt    time.Time          `bson:"updated_at" json:"updated_at"`
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	RestaurantID   primitive.ObjectID `bson:"restaurant_id" json:"restaurant_id"`
+	CategoryID     primitive.ObjectID `bson:"category_id" json:"category_id"`
+	Type           string             `bson:"type" json:"type"`
+	Name           string             `bson:"name" json:"name"`
+	Description    string             `bson:"description" json:"description"`
+	IsVeg          bool               `bson:"is_veg" json:"is_veg"`
+	InStock        bool               `bson:"in_stock"  json:"in_stock"`
+	StockOverride  *bool              `bson:"stock_override,omitempty" json:"stock_override,omitempty"`
+	ModifierGroups []ModifierGroupDao `bson:"modifier_groups" json:"modifier_groups"`
+	Slots          []ComboSlotDao     `bson:"slots,omitempty" json:"slots,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at" json:"updated_at"`
 }
 
 // GetProductDao converts and returns product Dao object from product schema
 func GetProductDao(product product.Product) (ProductDao, errors.AppError) {
 	productDao := ProductDao{
-		Name:        product.Name,
-		Description: product.Description,
-		IsVeg:       product.IsVeg,
-		InStock:     product.InStock,
-		CreatedAt:   product.CreatedAt,
-		UpdatedAt:   product.UpdatedAt,
+		Type:           product.Type,
+		Name:           product.Name,
+		Description:    product.Description,
+		IsVeg:          product.IsVeg,
+		InStock:        product.InStock,
+		StockOverride:  product.StockOverride,
+		ModifierGroups: make([]ModifierGroupDao, 0, len(product.ModifierGroups)),
+		CreatedAt:      product.CreatedAt,
+		UpdatedAt:      product.UpdatedAt,
+	}
+
+	for _, group := range product.ModifierGroups {
+		groupDao, err := GetModifierGroupDao(group)
+		if err != nil {
+			return ProductDao{}, err
+		}
+		productDao.ModifierGroups = append(productDao.ModifierGroups, groupDao)
+	}
+
+	for _, slot := range product.Slots {
+		slotDao, err := GetComboSlotDao(slot)
+		if err != nil {
+			return ProductDao{}, err
+		}
+		productDao.Slots = append(productDao.Slots, slotDao)
 	}
 
 	if product.ID != "" {
@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	"github.com/dhyaniarun1993/foody-common/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ReservationItemDao provides the schema definition for item of the reservation to be stored in mongodb
+type ReservationItemDao struct {
+	ProductID primitive.ObjectID `bson:"product_id" json:"product_id"`
+	VariantID primitive.ObjectID `bson:"variant_id" json:"variant_id"`
+	Quantity  int64              `bson:"quantity" json:"quantity"`
+	Reserved  bool               `bson:"reserved" json:"reserved"`
+}
+
+// ReservationDao provides the model definition for reservation data to be stored in mongodb
+type ReservationDao struct {
+	ID           primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
+	OrderID      string               `bson:"order_id" json:"order_id"`
+	RestaurantID primitive.ObjectID   `bson:"restaurant_id" json:"restaurant_id"`
+	Items        []ReservationItemDao `bson:"items" json:"items"`
+	Status       string               `bson:"status" json:"status"`
+	ExpiresAt    time.Time            `bson:"expires_at" json:"expires_at"`
+	ReservedOn   string               `bson:"reserved_on" json:"reserved_on"`
+	ClosedAt     *time.Time           `bson:"closed_at,omitempty" json:"closed_at,omitempty"`
+	CreatedAt    time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time            `bson:"updated_at" json:"updated_at"`
+}
+
+// GetReservationDao converts and returns reservation Dao object from reservation schema
+func GetReservationDao(reservation product.Reservation) (ReservationDao, errors.AppError) {
+	reservationDao := ReservationDao{
+		OrderID:    reservation.OrderID,
+		Items:      make([]ReservationItemDao, 0, len(reservation.Items)),
+		Status:     reservation.Status,
+		ExpiresAt:  reservation.ExpiresAt,
+		ReservedOn: reservation.ReservedOn,
+		ClosedAt:   reservation.ClosedAt,
+		CreatedAt:  reservation.CreatedAt,
+		UpdatedAt:  reservation.UpdatedAt,
+	}
+
+	if reservation.ID != "" {
+		reservationObjectID, err := primitive.ObjectIDFromHex(reservation.ID)
+		if err != nil {
+			return ReservationDao{}, errors.NewAppError("Something went wrong", http.StatusInternalServerError, err)
+		}
+		reservationDao.ID = reservationObjectID
+	}
+
+	// restaurant id is required
+	restaurantObjectID, err := primitive.ObjectIDFromHex(reservation.RestaurantID)
+	if err != nil {
+		return ReservationDao{}, errors.NewAppError("Something went wrong", http.StatusInternalServerError, err)
+	}
+	reservationDao.RestaurantID = restaurantObjectID
+
+	for _, item := range reservation.Items {
+		productObjectID, err := primitive.ObjectIDFromHex(item.ProductID)
+		if err != nil {
+			return ReservationDao{}, errors.NewAppError("Something went wrong", http.StatusInternalServerError, err)
+		}
+		variantObjectID, err := primitive.ObjectIDFromHex(item.VariantID)
+		if err != nil {
+			return ReservationDao{}, errors.NewAppError("Something went wrong", http.StatusInternalServerError, err)
+		}
+		reservationDao.Items = append(reservationDao.Items, ReservationItemDao{
+			ProductID: productObjectID,
+			VariantID: variantObjectID,
+			Quantity:  item.Quantity,
+			Reserved:  item.Reserved,
+		})
+	}
+
+	return reservationDao, nil
+}
@@ -8,8 +8,10 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	mongoDriver "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	productUsecase "github.com/dhyaniarun1993/foody-catalog-service/product/usecase"
 	"github.com/dhyaniarun1993/foody-catalog-service/repositories"
 	"github.com/dhyaniarun1993/foody-catalog-service/repositories/mongo/dao"
 	"github.com/dhyaniarun1993/foody-common/datastore/mongo"
@@ -17,8 +19,13 @@ import (
 )
 
 const (
-	productCollection = "product"
-	variantCollection = "variant"
+	productCollection   = "product"
+	variantCollection   = "variant"
+	migrationCollection = "migration"
+
+	// stockOverrideMigration marks that the stock of the products stored before the stock of the
+	// product was derived from its variants is migrated
+	stockOverrideMigration = "product_stock_override"
 )
 
 type productRepository struct {
@@ -37,6 +44,8 @@ func (db *productRepository) CreateProduct(ctx context.Context,
 	product.ID = ""
 	product.CreatedAt = time.Now()
 	product.UpdatedAt = time.Now()
+	assignModifierIDs(product.ModifierGroups)
+	assignSlotIDs(product.Slots)
 
 	productDao, daoErr := dao.GetProductDao(product)
 	if daoErr != nil {
@@ -45,7 +54,7 @@ func (db *productRepository) CreateProduct(ctx context.Context,
 
 	insertProductCtx, insertProductCancel := context.WithTimeout(ctx, 1*time.Second)
 	defer insertProductCancel()
-	// Todo: insert product and variant in transaction
+	// product and its variants are inserted atomically when called in a unit of work
 	// insert product data in datastore
 	productCollection := db.Database(db.database).Collection(productCollection)
 	insertProductResult, insertProductError := productCollection.InsertOne(insertProductCtx, productDao)
@@ -122,6 +131,114 @@ func (db *productRepository) CreateVariant(ctx context.Context,
 	return variant, nil
 }
 
+func (db *productRepository) UpdateProduct(ctx context.Context,
+	product product.Product) (product.Product, errors.AppError) {
+
+	product.UpdatedAt = time.Now()
+	assignModifierIDs(product.ModifierGroups)
+	assignSlotIDs(product.Slots)
+
+	productDao, daoErr := dao.GetProductDao(product)
+	if daoErr != nil {
+		return product, daoErr
+	}
+
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	// restaurant, type and variants of the product are not updated
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: productDao.ID,
+		},
+	}
+	update := bson.D{
+		{
+			Key: "$set",
+			Value: bson.D{
+				{Key: "category_id", Value: productDao.CategoryID},
+				{Key: "name", Value: productDao.Name},
+				{Key: "description", Value: productDao.Description},
+				{Key: "is_veg", Value: productDao.IsVeg},
+				{Key: "in_stock", Value: productDao.InStock},
+				{Key: "stock_override", Value: productDao.StockOverride},
+				{Key: "modifier_groups", Value: productDao.ModifierGroups},
+				{Key: "slots", Value: productDao.Slots},
+				{Key: "updated_at", Value: productDao.UpdatedAt},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(productCollection)
+
+	_, updateError := collection.UpdateOne(updateCtx, filter, update)
+	if updateError != nil {
+		return product, operationError(updateError)
+	}
+	return product, nil
+}
+
+func (db *productRepository) UpdateVariant(ctx context.Context,
+	variant product.Variant) (product.Variant, errors.AppError) {
+
+	variant.UpdatedAt = time.Now()
+
+	variantDao, daoErr := dao.GetVariantDao(variant)
+	if daoErr != nil {
+		return variant, daoErr
+	}
+
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: variantDao.ID,
+		},
+	}
+	fields := bson.D{
+		{Key: "name", Value: variantDao.Name},
+		{Key: "description", Value: variantDao.Description},
+		{Key: "price", Value: variantDao.Price},
+		{Key: "daily_stock_quantity", Value: variantDao.DailyStockQuantity},
+		{Key: "stock_reset_on", Value: variantDao.StockResetOn},
+		{Key: "updated_at", Value: variantDao.UpdatedAt},
+	}
+	update := bson.D{
+		{
+			Key:   "$set",
+			Value: fields,
+		},
+	}
+
+	// stock of the variant tracked by quantity only changes along with its quantity,
+	// so that concurrent adjustments are not overwritten
+	if variantDao.StockQuantity == nil {
+		update = bson.D{
+			{
+				Key:   "$set",
+				Value: append(fields, bson.E{Key: "in_stock", Value: variantDao.InStock}),
+			},
+			{
+				Key: "$unset",
+				Value: bson.D{
+					{Key: "stock_quantity", Value: ""},
+				},
+			},
+		}
+	}
+
+	collection := db.Database(db.database).Collection(variantCollection)
+
+	_, updateError := collection.UpdateOne(updateCtx, filter, update)
+	if updateError != nil {
+		return variant, operationError(updateError)
+	}
+	return variant, nil
+}
+
 func (db *productRepository) GetProductByID(ctx context.Context,
 	productID string) (product.Product, errors.AppError) {
 
@@ -153,8 +270,7 @@ func (db *productRepository) GetProductByID(ctx context.Context,
 	collection := db.Database(db.database).Collection(productCollection)
 	cursor, findError := collection.Aggregate(findCtx, mongoDriver.Pipeline{match, lookupVariants})
 	if findError != nil {
-		return product.Product{}, errors.NewAppError("Something went wrong",
-			http.StatusInternalServerError, findError)
+		return product.Product{}, operationError(findError)
 	}
 
 	cursorCtx, cursorCancel := context.WithTimeout(ctx, 1*time.Second)
@@ -163,8 +279,7 @@ func (db *productRepository) GetProductByID(ctx context.Context,
 	if cursor.Next(cursorCtx) {
 		decodeError := cursor.Decode(&productObj)
 		if decodeError != nil {
-			return product.Product{}, errors.NewAppError("Something went wrong",
-				http.StatusInternalServerError, decodeError)
+			return product.Product{}, operationError(decodeError)
 		}
 	}
 	return productObj, nil
@@ -187,8 +302,7 @@ func (db *productRepository) GetVariantByID(ctx context.Context, variantID strin
 	collection := db.Database(db.database).Collection(variantCollection)
 	cursor, findError := collection.Find(findCtx, filter)
 	if findError != nil {
-		return product.Variant{}, errors.NewAppError("Something went wrong",
-			http.StatusInternalServerError, findError)
+		return product.Variant{}, operationError(findError)
 	}
 
 	cursorCtx, cursorCancel := context.WithTimeout(ctx, 1*time.Second)
@@ -197,13 +311,270 @@ func (db *productRepository) GetVariantByID(ctx context.Context, variantID strin
 	if cursor.Next(cursorCtx) {
 		decodeError := cursor.Decode(&variantObj)
 		if decodeError != nil {
-			return product.Variant{}, errors.NewAppError("Something went wrong",
-				http.StatusInternalServerError, decodeError)
+			return product.Variant{}, operationError(decodeError)
 		}
 	}
 	return variantObj, nil
 }
 
+func (db *productRepository) GetProductsByIDs(ctx context.Context,
+	productIDs []string) ([]product.Product, errors.AppError) {
+
+	products := []product.Product{}
+	aggregateCtx, aggregateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer aggregateCancel()
+
+	// invalid ids can't match any product so they are skipped
+	productObjectIDs := []primitive.ObjectID{}
+	for _, productID := range productIDs {
+		productObjectID, convertError := primitive.ObjectIDFromHex(productID)
+		if convertError == nil {
+			productObjectIDs = append(productObjectIDs, productObjectID)
+		}
+	}
+
+	match := bson.D{
+		{
+			Key: "$match",
+			Value: bson.D{
+				{
+					Key: "_id",
+					Value: bson.D{
+						{Key: "$in", Value: productObjectIDs},
+					},
+				},
+			},
+		},
+	}
+	lookupVariants := bson.D{
+		{
+			Key: "$lookup",
+			Value: bson.D{
+				{Key: "localField", Value: "_id"},
+				{Key: "from", Value: variantCollection},
+				{Key: "foreignField", Value: "product_id"},
+				{Key: "as", Value: "variants"},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(productCollection)
+	cursor, aggregateError := collection.Aggregate(aggregateCtx, mongoDriver.Pipeline{match, lookupVariants})
+	if aggregateError != nil {
+		return products, operationError(aggregateError)
+	}
+
+	cursorCtx, cursorCancel := context.WithCancel(ctx)
+	defer cursorCancel()
+	for cursor.Next(cursorCtx) {
+		var productObj product.Product
+		decodeError := cursor.Decode(&productObj)
+		if decodeError != nil {
+			return products, operationError(decodeError)
+		}
+		products = append(products, productObj)
+	}
+	return products, nil
+}
+
+func (db *productRepository) GetCombosByComponentIDs(ctx context.Context,
+	productIDs []string) ([]product.Product, errors.AppError) {
+
+	combos := []product.Product{}
+	aggregateCtx, aggregateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer aggregateCancel()
+
+	productObjectIDs := []primitive.ObjectID{}
+	for _, productID := range productIDs {
+		productObjectID, convertError := primitive.ObjectIDFromHex(productID)
+		if convertError == nil {
+			productObjectIDs = append(productObjectIDs, productObjectID)
+		}
+	}
+
+	match := bson.D{
+		{
+			Key: "$match",
+			Value: bson.D{
+				{Key: "type", Value: product.TypeCombo},
+				{
+					Key: "slots.choices.product_id",
+					Value: bson.D{
+						{Key: "$in", Value: productObjectIDs},
+					},
+				},
+			},
+		},
+	}
+	lookupVariants := bson.D{
+		{
+			Key: "$lookup",
+			Value: bson.D{
+				{Key: "localField", Value: "_id"},
+				{Key: "from", Value: variantCollection},
+				{Key: "foreignField", Value: "product_id"},
+				{Key: "as", Value: "variants"},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(productCollection)
+	cursor, aggregateError := collection.Aggregate(aggregateCtx, mongoDriver.Pipeline{match, lookupVariants})
+	if aggregateError != nil {
+		return combos, operationError(aggregateError)
+	}
+
+	cursorCtx, cursorCancel := context.WithCancel(ctx)
+	defer cursorCancel()
+	for cursor.Next(cursorCtx) {
+		var combo product.Product
+		decodeError := cursor.Decode(&combo)
+		if decodeError != nil {
+			return combos, operationError(decodeError)
+		}
+		combos = append(combos, combo)
+	}
+	return combos, nil
+}
+
+func (db *productRepository) CountCombosByComponentCategoryIDs(ctx context.Context,
+	categoryIDs []string) (int64, errors.AppError) {
+
+	categoryObjectIDs := []primitive.ObjectID{}
+	for _, categoryID := range categoryIDs {
+		categoryObjectID, _ := primitive.ObjectIDFromHex(categoryID)
+		categoryObjectIDs = append(categoryObjectIDs, categoryObjectID)
+	}
+
+	findCtx, findCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer findCancel()
+
+	// find all the products of the provided categories
+	collection := db.Database(db.database).Collection(productCollection)
+	cursor, findError := collection.Find(findCtx, bson.D{
+		{
+			Key: "category_id",
+			Value: bson.D{
+				{Key: "$in", Value: categoryObjectIDs},
+			},
+		},
+	})
+	if findError != nil {
+		return 0, operationError(findError)
+	}
+
+	productIDs := []primitive.ObjectID{}
+	cursorCtx, cursorCancel := context.WithCancel(ctx)
+	defer cursorCancel()
+	for cursor.Next(cursorCtx) {
+		var productDao dao.ProductDao
+		decodeError := cursor.Decode(&productDao)
+		if decodeError != nil {
+			return 0, operationError(decodeError)
+		}
+		productIDs = append(productIDs, productDao.ID)
+	}
+
+	countCtx, countCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer countCancel()
+
+	// combos of the provided categories are deleted along with their components
+	count, countError := collection.CountDocuments(countCtx, bson.D{
+		{Key: "type", Value: product.TypeCombo},
+		{
+			Key: "category_id",
+			Value: bson.D{
+				{Key: "$nin", Value: categoryObjectIDs},
+			},
+		},
+		{
+			Key: "slots.choices.product_id",
+			Value: bson.D{
+				{Key: "$in", Value: productIDs},
+			},
+		},
+	})
+	if countError != nil {
+		return 0, operationError(countError)
+	}
+	return count, nil
+}
+
+func (db *productRepository) GetAllProducts(ctx context.Context,
+	query productUsecase.GetAllProductsRequest) ([]product.Product, errors.AppError) {
+
+	products := []product.Product{}
+	offset := (query.PageNumber - 1) * query.PageSize
+	aggregateCtx, aggregateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer aggregateCancel()
+
+	match := bson.D{
+		{
+			Key:   "$match",
+			Value: getProductFilter(query),
+		},
+	}
+	sort := bson.D{
+		{
+			Key: "$sort",
+			Value: bson.D{
+				{Key: "created_at", Value: 1},
+			},
+		},
+	}
+	skip := bson.D{
+		{Key: "$skip", Value: offset},
+	}
+	limit := bson.D{
+		{Key: "$limit", Value: query.PageSize},
+	}
+	// variants are looked up only for the products of the requested page
+	lookupVariants := bson.D{
+		{
+			Key: "$lookup",
+			Value: bson.D{
+				{Key: "localField", Value: "_id"},
+				{Key: "from", Value: variantCollection},
+				{Key: "foreignField", Value: "product_id"},
+				{Key: "as", Value: "variants"},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(productCollection)
+	cursor, aggregateError := collection.Aggregate(aggregateCtx,
+		mongoDriver.Pipeline{match, sort, skip, limit, lookupVariants})
+	if aggregateError != nil {
+		return products, operationError(aggregateError)
+	}
+
+	cursorCtx, cursorCancel := context.WithCancel(ctx)
+	defer cursorCancel()
+	for cursor.Next(cursorCtx) {
+		var productObj product.Product
+		decodeError := cursor.Decode(&productObj)
+		if decodeError != nil {
+			return products, operationError(decodeError)
+		}
+		products = append(products, productObj)
+	}
+	return products, nil
+}
+
+func (db *productRepository) GetAllProductsTotalCount(ctx context.Context,
+	query productUsecase.GetAllProductsRequest) (int64, errors.AppError) {
+
+	countCtx, countCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer countCancel()
+
+	collection := db.Database(db.database).Collection(productCollection)
+	count, countError := collection.CountDocuments(countCtx, getProductFilter(query))
+	if countError != nil {
+		return 0, operationError(countError)
+	}
+	return count, nil
+}
+
 func (db *productRepository) DeleteProductByID(ctx context.Context, productID string) errors.AppError {
 
 	productObjectID, _ := primitive.ObjectIDFromHex(productID)
@@ -220,7 +591,7 @@ func (db *productRepository) DeleteProductByID(ctx context.Context, productID st
 	variantCollection := db.Database(db.database).Collection(variantCollection)
 	_, deleteVariantError := variantCollection.DeleteMany(deleteVariantCtx, deleteVariantFilter)
 	if deleteVariantError != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, deleteVariantError)
+		return operationError(deleteVariantError)
 	}
 
 	deleteProductFilter := bson.D{
@@ -236,15 +607,37 @@ func (db *productRepository) DeleteProductByID(ctx context.Context, productID st
 	productCollection := db.Database(db.database).Collection(productCollection)
 	_, deleteProductError := productCollection.DeleteOne(deleteProductCtx, deleteProductFilter)
 	if deleteProductError != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, deleteProductError)
+		return operationError(deleteProductError)
 	}
 
 	return nil
 }
 
-func (db *productRepository) DeleteVariantByID(ctx context.Context,
+// DeleteVariantByID deletes the variant of the product unless it is the last variant of the product.
+// It should run in a unit of work, product is updated first so concurrent removals of the variants
+// of the same product conflict instead of removing all of them
+func (db *productRepository) DeleteVariantByID(ctx context.Context, productID string,
 	variantID string) errors.AppError {
 
+	productObjectID, _ := primitive.ObjectIDFromHex(productID)
+	updateProductCtx, updateProductCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateProductCancel()
+
+	productCollection := db.Database(db.database).Collection(productCollection)
+	_, updateProductError := productCollection.UpdateOne(updateProductCtx, bson.D{
+		{Key: "_id", Value: productObjectID},
+	}, bson.D{
+		{
+			Key: "$set",
+			Value: bson.D{
+				{Key: "updated_at", Value: time.Now()},
+			},
+		},
+	})
+	if updateProductError != nil {
+		return operationError(updateProductError)
+	}
+
 	deleteCtx, deleteCancel := context.WithTimeout(ctx, 1*time.Second)
 	defer deleteCancel()
 
@@ -254,13 +647,505 @@ func (db *productRepository) DeleteVariantByID(ctx context.Context,
 			Key:   "_id",
 			Value: objectID,
 		},
+		{
+			Key:   "product_id",
+			Value: productObjectID,
+		},
 	}
 
 	collection := db.Database(db.database).Collection(variantCollection)
 
 	_, deleteErr := collection.DeleteOne(deleteCtx, filter)
 	if deleteErr != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, deleteErr)
+		return operationError(deleteErr)
+	}
+
+	countCtx, countCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer countCancel()
+
+	count, countError := collection.CountDocuments(countCtx, bson.D{
+		{Key: "product_id", Value: productObjectID},
+	})
+	if countError != nil {
+		return operationError(countError)
+	}
+	if count == 0 {
+		return errors.NewAppError("Product should have at least one variant", http.StatusConflict, nil)
+	}
+	return nil
+}
+
+func (db *productRepository) UpdateProductStock(ctx context.Context, productID string,
+	stockOverride *bool, inStock bool) errors.AppError {
+
+	return db.updateStock(ctx, productCollection, productID, bson.D{
+		{Key: "stock_override", Value: stockOverride},
+		{Key: "in_stock", Value: inStock},
+		{Key: "updated_at", Value: time.Now()},
+	})
+}
+
+func (db *productRepository) UpdateVariantStock(ctx context.Context, variantID string,
+	inStock bool) errors.AppError {
+
+	return db.updateStock(ctx, variantCollection, variantID, bson.D{
+		{Key: "in_stock", Value: inStock},
+		{Key: "updated_at", Value: time.Now()},
+	})
+}
+
+func (db *productRepository) SetVariantQuantity(ctx context.Context, variantID string,
+	quantity int64) errors.AppError {
+
+	return db.updateStock(ctx, variantCollection, variantID, bson.D{
+		{Key: "stock_quantity", Value: quantity},
+		{Key: "in_stock", Value: quantity > 0},
+		{Key: "updated_at", Value: time.Now()},
+	})
+}
+
+// AdjustVariantQuantity atomically increments the quantity of the variant by delta, adjustment
+// which would take the quantity below zero is rejected. Stock of the variant follows its quantity
+func (db *productRepository) AdjustVariantQuantity(ctx context.Context, variantID string,
+	delta int64) (product.Variant, errors.AppError) {
+
+	var variantObj product.Variant
+	adjustCtx, adjustCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer adjustCancel()
+
+	minimumQuantity := int64(0)
+	if delta < 0 {
+		minimumQuantity = -delta
+	}
+
+	variantObjectID, _ := primitive.ObjectIDFromHex(variantID)
+	filter := bson.D{
+		{Key: "_id", Value: variantObjectID},
+		{
+			Key: "stock_quantity",
+			Value: bson.D{
+				{Key: "$gte", Value: minimumQuantity},
+			},
+		},
+	}
+	update := bson.D{
+		{
+			Key: "$inc",
+			Value: bson.D{
+				{Key: "stock_quantity", Value: delta},
+			},
+		},
+		{
+			Key: "$set",
+			Value: bson.D{
+				{Key: "updated_at", Value: time.Now()},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(variantCollection)
+	decodeError := collection.FindOneAndUpdate(adjustCtx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&variantObj)
+	if decodeError == mongoDriver.ErrNoDocuments {
+		return product.Variant{}, errors.NewAppError("Insufficient stock quantity", http.StatusConflict, nil)
+	}
+	if decodeError != nil {
+		return product.Variant{}, operationError(decodeError)
+	}
+
+	// stock is only changed if the quantity is still on the same side of zero, adjustments
+	// racing with this one set the stock for their own quantity
+	inStock := *variantObj.StockQuantity > 0
+	var quantityFilter interface{} = int64(0)
+	if inStock {
+		quantityFilter = bson.D{
+			{Key: "$gt", Value: 0},
+		}
+	}
+	stockFilter := bson.D{
+		{Key: "_id", Value: variantObjectID},
+		{Key: "stock_quantity", Value: quantityFilter},
+		{Key: "in_stock", Value: !inStock},
+	}
+	stockUpdate := bson.D{
+		{
+			Key: "$set",
+			Value: bson.D{
+				{Key: "in_stock", Value: inStock},
+			},
+		},
+	}
+
+	_, updateError := collection.UpdateOne(adjustCtx, stockFilter, stockUpdate)
+	if updateError != nil {
+		return product.Variant{}, operationError(updateError)
+	}
+	variantObj.InStock = &inStock
+	return variantObj, nil
+}
+
+// ResetDailyStock resets the quantity of the variants with daily quantity whose reset date is
+// behind the current date in the time zone of their restaurant and returns the ids of their products
+func (db *productRepository) ResetDailyStock(ctx context.Context, now time.Time) ([]string, errors.AppError) {
+	// every tracked variant is scanned, so the job is given more time than a request
+	aggregateCtx, aggregateCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer aggregateCancel()
+
+	matchDaily := bson.D{
+		{
+			Key: "$match",
+			Value: bson.D{
+				{
+					Key: "daily_stock_quantity",
+					Value: bson.D{
+						{Key: "$ne", Value: nil},
+					},
+				},
+			},
+		},
+	}
+	lookupProduct := bson.D{
+		{
+			Key: "$lookup",
+			Value: bson.D{
+				{Key: "localField", Value: "product_id"},
+				{Key: "from", Value: productCollection},
+				{Key: "foreignField", Value: "_id"},
+				{Key: "as", Value: "product"},
+			},
+		},
+	}
+	unwindProduct := bson.D{
+		{Key: "$unwind", Value: "$product"},
+	}
+	lookupRestaurant := bson.D{
+		{
+			Key: "$lookup",
+			Value: bson.D{
+				{Key: "localField", Value: "product.restaurant_id"},
+				{Key: "from", Value: restaurantCollection},
+				{Key: "foreignField", Value: "_id"},
+				{Key: "as", Value: "restaurant"},
+			},
+		},
+	}
+	unwindRestaurant := bson.D{
+		{Key: "$unwind", Value: "$restaurant"},
+	}
+	projectLocalDate := bson.D{
+		{
+			Key: "$project",
+			Value: bson.D{
+				{Key: "product_id", Value: 1},
+				{Key: "daily_stock_quantity", Value: 1},
+				{Key: "stock_reset_on", Value: 1},
+				{
+					Key: "local_date",
+					Value: bson.D{
+						{
+							Key: "$dateToString",
+							Value: bson.D{
+								{Key: "format", Value: "%Y-%m-%d"},
+								{Key: "date", Value: now},
+								// restaurant without time zone is in UTC
+								{
+									Key: "timezone",
+									Value: bson.D{
+										{Key: "$ifNull", Value: bson.A{"$restaurant.time_zone", "UTC"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	matchDue := bson.D{
+		{
+			Key: "$match",
+			Value: bson.D{
+				{
+					Key: "$expr",
+					Value: bson.D{
+						{Key: "$ne", Value: bson.A{"$stock_reset_on", "$local_date"}},
+					},
+				},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(variantCollection)
+	cursor, aggregateError := collection.Aggregate(aggregateCtx, mongoDriver.Pipeline{matchDaily,
+		lookupProduct, unwindProduct, lookupRestaurant, unwindRestaurant, projectLocalDate, matchDue})
+	if aggregateError != nil {
+		return nil, operationError(aggregateError)
+	}
+	defer cursor.Close(aggregateCtx)
+
+	productIDs := []string{}
+	seen := map[primitive.ObjectID]bool{}
+	models := []mongoDriver.WriteModel{}
+	for cursor.Next(aggregateCtx) {
+		var due struct {
+			ID                 primitive.ObjectID `bson:"_id"`
+			ProductID          primitive.ObjectID `bson:"product_id"`
+			DailyStockQuantity int64              `bson:"daily_stock_quantity"`
+			LocalDate          string             `bson:"local_date"`
+		}
+		decodeError := cursor.Decode(&due)
+		if decodeError != nil {
+			return nil, operationError(decodeError)
+		}
+
+		// variant reset concurrently by another instance is skipped
+		models = append(models, mongoDriver.NewUpdateOneModel().
+			SetFilter(bson.D{
+				{Key: "_id", Value: due.ID},
+				{
+					Key: "stock_reset_on",
+					Value: bson.D{
+						{Key: "$ne", Value: due.LocalDate},
+					},
+				},
+			}).
+			SetUpdate(bson.D{
+				{
+					Key: "$set",
+					Value: bson.D{
+						{Key: "stock_quantity", Value: due.DailyStockQuantity},
+						{Key: "in_stock", Value: due.DailyStockQuantity > 0},
+						{Key: "stock_reset_on", Value: due.LocalDate},
+						{Key: "updated_at", Value: now},
+					},
+				},
+			}))
+		if !seen[due.ProductID] {
+			seen[due.ProductID] = true
+			productIDs = append(productIDs, due.ProductID.Hex())
+		}
+	}
+	if cursorError := cursor.Err(); cursorError != nil {
+		return nil, operationError(cursorError)
+	}
+
+	if len(models) == 0 {
+		return productIDs, nil
+	}
+	_, bulkError := collection.BulkWrite(aggregateCtx, models, options.BulkWrite().SetOrdered(false))
+	if bulkError != nil {
+		return nil, operationError(bulkError)
+	}
+	return productIDs, nil
+}
+
+// updateStock sets the provided stock fields of the product or variant in the provided collection
+func (db *productRepository) updateStock(ctx context.Context, collectionName string, id string,
+	fields bson.D) errors.AppError {
+
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	objectID, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: objectID,
+		},
+	}
+	update := bson.D{
+		{
+			Key:   "$set",
+			Value: fields,
+		},
+	}
+
+	collection := db.Database(db.database).Collection(collectionName)
+
+	_, updateError := collection.UpdateOne(updateCtx, filter, update)
+	if updateError != nil {
+		return operationError(updateError)
+	}
+	return nil
+}
+
+// BulkUpdateStock updates the stock of all the items and reports which of them were updated.
+// Product and variant stock live in different collections so one unordered bulk write
+// is performed per collection.
+func (db *productRepository) BulkUpdateStock(ctx context.Context,
+	items []productUsecase.StockItem) ([]bool, errors.AppError) {
+
+	updated := make([]bool, len(items))
+	productModels := []mongoDriver.WriteModel{}
+	productIndexes := []int{}
+	variantModels := []mongoDriver.WriteModel{}
+	variantIndexes := []int{}
+	now := time.Now()
+
+	for i, item := range items {
+		// stock of the product item is stored as its stock override
+		id, field := item.ProductID, "stock_override"
+		if item.VariantID != "" {
+			id, field = item.VariantID, "in_stock"
+		}
+		objectID, _ := primitive.ObjectIDFromHex(id)
+		model := mongoDriver.NewUpdateOneModel().
+			SetFilter(bson.D{
+				{Key: "_id", Value: objectID},
+			}).
+			SetUpdate(bson.D{
+				{
+					Key: "$set",
+					Value: bson.D{
+						{Key: field, Value: *item.InStock},
+						{Key: "updated_at", Value: now},
+					},
+				},
+			})
+
+		if item.VariantID != "" {
+			variantModels = append(variantModels, model)
+			variantIndexes = append(variantIndexes, i)
+		} else {
+			productModels = append(productModels, model)
+			productIndexes = append(productIndexes, i)
+		}
+	}
+
+	bulkError := db.bulkUpdate(ctx, productCollection, productModels, productIndexes, updated)
+	if bulkError != nil {
+		return updated, bulkError
+	}
+	bulkError = db.bulkUpdate(ctx, variantCollection, variantModels, variantIndexes, updated)
+	if bulkError != nil {
+		return updated, bulkError
+	}
+	return updated, nil
+}
+
+// SyncProductStock stores the derived availability of the provided products in a single bulk write
+func (db *productRepository) SyncProductStock(ctx context.Context,
+	products []product.Product) errors.AppError {
+
+	if len(products) == 0 {
+		return nil
+	}
+
+	models := make([]mongoDriver.WriteModel, 0, len(products))
+	now := time.Now()
+	for _, productObj := range products {
+		productObjectID, _ := primitive.ObjectIDFromHex(productObj.ID)
+		models = append(models, mongoDriver.NewUpdateOneModel().
+			SetFilter(bson.D{
+				{Key: "_id", Value: productObjectID},
+			}).
+			SetUpdate(bson.D{
+				{
+					Key: "$set",
+					Value: bson.D{
+						{Key: "in_stock", Value: productObj.InStock},
+						{Key: "updated_at", Value: now},
+					},
+				},
+			}))
+	}
+
+	bulkCtx, bulkCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer bulkCancel()
+
+	collection := db.Database(db.database).Collection(productCollection)
+	_, bulkError := collection.BulkWrite(bulkCtx, models, options.BulkWrite().SetOrdered(false))
+	if bulkError != nil {
+		return operationError(bulkError)
+	}
+	return nil
+}
+
+// bulkUpdate performs unordered bulk write of the models on the provided collection and marks
+// the indexes of the models that didn't fail as updated
+func (db *productRepository) bulkUpdate(ctx context.Context, collectionName string,
+	models []mongoDriver.WriteModel, indexes []int, updated []bool) errors.AppError {
+
+	if len(models) == 0 {
+		return nil
+	}
+
+	bulkCtx, bulkCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer bulkCancel()
+
+	collection := db.Database(db.database).Collection(collectionName)
+	_, bulkError := collection.BulkWrite(bulkCtx, models, options.BulkWrite().SetOrdered(false))
+
+	failed := map[int]bool{}
+	if bulkError != nil {
+		bulkWriteException, ok := bulkError.(mongoDriver.BulkWriteException)
+		if !ok || bulkWriteException.WriteConcernError != nil {
+			return operationError(bulkError)
+		}
+		for _, writeError := range bulkWriteException.WriteErrors {
+			failed[writeError.Index] = true
+		}
+	}
+
+	for i, index := range indexes {
+		updated[index] = !failed[i]
+	}
+	return nil
+}
+
+// MigrateStockOverride keeps the products marked out of stock before the stock of the product was
+// derived from its variants out of stock, by moving their stock to the StockOverride. Products are
+// migrated once, later products are out of stock when their variants are
+func (db *productRepository) MigrateStockOverride(ctx context.Context) errors.AppError {
+
+	migrationCtx, migrationCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer migrationCancel()
+
+	migration := db.Database(db.database).Collection(migrationCollection)
+	migrationFilter := bson.D{
+		{Key: "_id", Value: stockOverrideMigration},
+	}
+	count, countError := migration.CountDocuments(migrationCtx, migrationFilter)
+	if countError != nil {
+		return operationError(countError)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	collection := db.Database(db.database).Collection(productCollection)
+	_, updateError := collection.UpdateMany(migrationCtx, bson.D{
+		{Key: "in_stock", Value: false},
+		{
+			Key: "stock_override",
+			Value: bson.D{
+				{Key: "$exists", Value: false},
+			},
+		},
+	}, bson.D{
+		{
+			Key: "$set",
+			Value: bson.D{
+				{Key: "stock_override", Value: false},
+			},
+		},
+	})
+	if updateError != nil {
+		return operationError(updateError)
+	}
+
+	_, insertError := migration.InsertOne(migrationCtx, bson.D{
+		{Key: "_id", Value: stockOverrideMigration},
+		{Key: "created_at", Value: time.Now()},
+	})
+	if insertError != nil {
+		// migration completed concurrently by another instance
+		writeException, ok := insertError.(mongoDriver.WriteException)
+		if ok && len(writeException.WriteErrors) > 0 &&
+			writeException.WriteErrors[0].Code == duplicateKeyErrorCode {
+			return nil
+		}
+		return operationError(insertError)
 	}
 	return nil
 }
@@ -268,7 +1153,7 @@ func (db *productRepository) DeleteVariantByID(ctx context.Context,
 func (db *productRepository) DeleteProductByRestaurantID(ctx context.Context,
 	restaurantID string) errors.AppError {
 
-	// Todo: Perform all the operations in transaction
+	// operations are performed atomically when called in a unit of work
 	findProductCtx, findProductCancel := context.WithTimeout(ctx, 1*time.Second)
 	defer findProductCancel()
 
@@ -284,7 +1169,7 @@ func (db *productRepository) DeleteProductByRestaurantID(ctx context.Context,
 	// find all the products with provided restaurant ID
 	cursor, findError := productCollection.Find(findProductCtx, productFilter)
 	if findError != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, findError)
+		return operationError(findError)
 	}
 
 	// create product ID list
@@ -295,7 +1180,7 @@ func (db *productRepository) DeleteProductByRestaurantID(ctx context.Context,
 		var productDao dao.ProductDao
 		decodeError := cursor.Decode(&productDao)
 		if decodeError != nil {
-			return errors.NewAppError("Something went wrong", http.StatusInternalServerError, decodeError)
+			return operationError(decodeError)
 		}
 		productIDs = append(productIDs, productDao.ID)
 	}
@@ -319,7 +1204,7 @@ func (db *productRepository) DeleteProductByRestaurantID(ctx context.Context,
 	variantCollection := db.Database(db.database).Collection(variantCollection)
 	_, deleteVariantError := variantCollection.DeleteMany(deleteVariantCtx, deleteVariantFilter)
 	if deleteVariantError != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, deleteVariantError)
+		return operationError(deleteVariantError)
 	}
 
 	deleteProductCtx, deleteProductCancel := context.WithTimeout(ctx, 1*time.Second)
@@ -328,7 +1213,7 @@ func (db *productRepository) DeleteProductByRestaurantID(ctx context.Context,
 	// delete all the products that belong to the restaurant
 	_, deleteProductError := productCollection.DeleteMany(deleteProductCtx, productFilter)
 	if deleteProductError != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, deleteProductError)
+		return operationError(deleteProductError)
 	}
 
 	return nil
@@ -337,7 +1222,7 @@ func (db *productRepository) DeleteProductByRestaurantID(ctx context.Context,
 func (db *productRepository) DeleteProductByCategoryID(ctx context.Context,
 	categoryID string) errors.AppError {
 
-	// Todo: Perform all the operations in transaction
+	// operations are performed atomically when called in a unit of work
 	findProductCtx, findProductCancel := context.WithTimeout(ctx, 1*time.Second)
 	defer findProductCancel()
 
@@ -353,7 +1238,7 @@ func (db *productRepository) DeleteProductByCategoryID(ctx context.Context,
 	// find all the products with provided category ID
 	cursor, findError := productCollection.Find(findProductCtx, productFilter)
 	if findError != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, findError)
+		return operationError(findError)
 	}
 
 	// create product ID list
@@ -364,7 +1249,7 @@ func (db *productRepository) DeleteProductByCategoryID(ctx context.Context,
 		var productDao dao.ProductDao
 		decodeError := cursor.Decode(&productDao)
 		if decodeError != nil {
-			return errors.NewAppError("Something went wrong", http.StatusInternalServerError, decodeError)
+			return operationError(decodeError)
 		}
 		productIDs = append(productIDs, productDao.ID)
 	}
@@ -388,7 +1273,7 @@ func (db *productRepository) DeleteProductByCategoryID(ctx context.Context,
 	variantCollection := db.Database(db.database).Collection(variantCollection)
 	_, deleteVariantError := variantCollection.DeleteMany(deleteVariantCtx, deleteVariantFilter)
 	if deleteVariantError != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, deleteVariantError)
+		return operationError(deleteVariantError)
 	}
 
 	deleteProductCtx, deleteProductCancel := context.WithTimeout(ctx, 1*time.Second)
@@ -397,8 +1282,50 @@ func (db *productRepository) DeleteProductByCategoryID(ctx context.Context,
 	// delete all the products that belong to the category provided
 	_, deleteProductError := productCollection.DeleteMany(deleteProductCtx, productFilter)
 	if deleteProductError != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, deleteProductError)
+		return operationError(deleteProductError)
 	}
 
 	return nil
 }
+
+// assignModifierIDs assigns new ids to the modifier groups and options that don't have one
+func assignModifierIDs(groups []product.ModifierGroup) {
+	for i := range groups {
+		if groups[i].ID == "" {
+			groups[i].ID = primitive.NewObjectID().Hex()
+		}
+		for j := range groups[i].Options {
+			if groups[i].Options[j].ID == "" {
+				groups[i].Options[j].ID = primitive.NewObjectID().Hex()
+			}
+		}
+	}
+}
+
+// assignSlotIDs assigns new ids to the combo slots that don't have one
+func assignSlotIDs(slots []product.ComboSlot) {
+	for i := range slots {
+		if slots[i].ID == "" {
+			slots[i].ID = primitive.NewObjectID().Hex()
+		}
+	}
+}
+
+// getProductFilter returns the filter for the products matching the query
+func getProductFilter(query productUsecase.GetAllProductsRequest) bson.D {
+	restaurantObjectID, _ := primitive.ObjectIDFromHex(query.RestaurantID)
+	filter := bson.D{
+		{Key: "restaurant_id", Value: restaurantObjectID},
+	}
+	if query.CategoryID != "" {
+		categoryObjectID, _ := primitive.ObjectIDFromHex(query.CategoryID)
+		filter = append(filter, bson.E{Key: "category_id", Value: categoryObjectID})
+	}
+	if query.IsVeg != nil {
+		filter = append(filter, bson.E{Key: "is_veg", Value: *query.IsVeg})
+	}
+	if query.InStock != nil {
+		filter = append(filter, bson.E{Key: "in_stock", Value: *query.InStock})
+	}
+	return filter
+}
@@ -0,0 +1,219 @@
+package mongo
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongoDriver "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	"github.com/dhyaniarun1993/foody-catalog-service/repositories"
+	"github.com/dhyaniarun1993/foody-catalog-service/repositories/mongo/dao"
+	"github.com/dhyaniarun1993/foody-common/datastore/mongo"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+const (
+	reservationCollection = "reservation"
+	duplicateKeyErrorCode = 11000
+)
+
+type reservationRepository struct {
+	*mongo.Client
+	database string
+}
+
+// NewReservationRepository creates and return reservation repository
+func NewReservationRepository(mongoClient *mongo.Client, database string) repositories.ReservationRepository {
+	return &reservationRepository{mongoClient, database}
+}
+
+func (db *reservationRepository) CreateReservation(ctx context.Context,
+	reservation product.Reservation) (product.Reservation, errors.AppError) {
+
+	reservation.ID = ""
+	reservation.CreatedAt = time.Now()
+	reservation.UpdatedAt = reservation.CreatedAt
+
+	reservationDao, daoErr := dao.GetReservationDao(reservation)
+	if daoErr != nil {
+		return reservation, daoErr
+	}
+
+	insertCtx, insertCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer insertCancel()
+
+	collection := db.Database(db.database).Collection(reservationCollection)
+	insertResult, insertError := collection.InsertOne(insertCtx, reservationDao)
+	if insertError != nil {
+		// order id is unique, reservation is created once per order
+		writeException, ok := insertError.(mongoDriver.WriteException)
+		if ok && len(writeException.WriteErrors) > 0 &&
+			writeException.WriteErrors[0].Code == duplicateKeyErrorCode {
+			return reservation, errors.NewAppError("Reservation already exists for the order",
+				http.StatusConflict, nil)
+		}
+		return reservation, operationError(insertError)
+	}
+
+	reservationObjectID, _ := insertResult.InsertedID.(primitive.ObjectID)
+	reservation.ID = reservationObjectID.Hex()
+	return reservation, nil
+}
+
+func (db *reservationRepository) GetReservationByOrderID(ctx context.Context,
+	orderID string) (product.Reservation, errors.AppError) {
+
+	var reservation product.Reservation
+	findCtx, findCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer findCancel()
+
+	filter := bson.D{
+		{Key: "order_id", Value: orderID},
+	}
+
+	collection := db.Database(db.database).Collection(reservationCollection)
+	decodeError := collection.FindOne(findCtx, filter).Decode(&reservation)
+	if decodeError != nil && decodeError != mongoDriver.ErrNoDocuments {
+		return product.Reservation{}, operationError(decodeError)
+	}
+	return reservation, nil
+}
+
+func (db *reservationRepository) GetExpiredReservations(ctx context.Context, now time.Time,
+	limit int64) ([]product.Reservation, errors.AppError) {
+
+	reservations := []product.Reservation{}
+	findCtx, findCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer findCancel()
+
+	filter := bson.D{
+		{Key: "status", Value: product.ReservationHeld},
+		{
+			Key: "expires_at",
+			Value: bson.D{
+				{Key: "$lte", Value: now},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(reservationCollection)
+	cursor, findError := collection.Find(findCtx, filter, options.Find().SetLimit(limit))
+	if findError != nil {
+		return reservations, operationError(findError)
+	}
+	defer cursor.Close(findCtx)
+
+	for cursor.Next(findCtx) {
+		var reservation product.Reservation
+		decodeError := cursor.Decode(&reservation)
+		if decodeError != nil {
+			return reservations, operationError(decodeError)
+		}
+		reservations = append(reservations, reservation)
+	}
+	return reservations, nil
+}
+
+// CloseReservation moves the held reservation of the order which expires after heldUntil to the
+// provided status. Only one caller can close the reservation, others get a conflict
+func (db *reservationRepository) CloseReservation(ctx context.Context, orderID string, status string,
+	heldUntil time.Time) (product.Reservation, errors.AppError) {
+
+	var reservation product.Reservation
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	now := time.Now()
+	filter := bson.D{
+		{Key: "order_id", Value: orderID},
+		{Key: "status", Value: product.ReservationHeld},
+		{
+			Key: "expires_at",
+			Value: bson.D{
+				{Key: "$gt", Value: heldUntil},
+			},
+		},
+	}
+	update := bson.D{
+		{
+			Key: "$set",
+			Value: bson.D{
+				{Key: "status", Value: status},
+				{Key: "closed_at", Value: now},
+				{Key: "updated_at", Value: now},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(reservationCollection)
+	decodeError := collection.FindOneAndUpdate(updateCtx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&reservation)
+	if decodeError == mongoDriver.ErrNoDocuments {
+		return product.Reservation{}, errors.NewAppError("Reservation is not held", http.StatusConflict, nil)
+	}
+	if decodeError != nil {
+		return product.Reservation{}, operationError(decodeError)
+	}
+	return reservation, nil
+}
+
+func (db *reservationRepository) DeleteReservationByID(ctx context.Context,
+	reservationID string) errors.AppError {
+
+	deleteCtx, deleteCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer deleteCancel()
+
+	reservationObjectID, _ := primitive.ObjectIDFromHex(reservationID)
+	filter := bson.D{
+		{Key: "_id", Value: reservationObjectID},
+	}
+
+	collection := db.Database(db.database).Collection(reservationCollection)
+	_, deleteError := collection.DeleteOne(deleteCtx, filter)
+	if deleteError != nil {
+		return operationError(deleteError)
+	}
+	return nil
+}
+
+// CreateIndexes creates the indexes of the reservation collection. Closed reservations are removed
+// by mongodb once they are older than the retention, held reservations are expired by the reaper
+func (db *reservationRepository) CreateIndexes(ctx context.Context, retention time.Duration) errors.AppError {
+
+	indexCtx, indexCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer indexCancel()
+
+	orderIndex := mongoDriver.IndexModel{
+		Keys: bson.D{
+			{Key: "order_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	}
+	expiryIndex := mongoDriver.IndexModel{
+		Keys: bson.D{
+			{Key: "status", Value: 1},
+			{Key: "expires_at", Value: 1},
+		},
+	}
+	// documents without closed_at, i.e. held reservations, are never removed by the TTL index
+	closedIndex := mongoDriver.IndexModel{
+		Keys: bson.D{
+			{Key: "closed_at", Value: 1},
+		},
+		Options: options.Index().SetExpireAfterSeconds(int32(retention.Seconds())),
+	}
+
+	collection := db.Database(db.database).Collection(reservationCollection)
+
+	_, indexError := collection.Indexes().CreateMany(indexCtx,
+		[]mongoDriver.IndexModel{orderIndex, expiryIndex, closedIndex})
+	if indexError != nil {
+		return operationError(indexError)
+	}
+	return nil
+}
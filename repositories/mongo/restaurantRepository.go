@@ -3,10 +3,12 @@ package mongo
 import (
 	"context"
 	"net/http"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongoDriver "go.mongodb.org/mongo-driver/mongo"
 	mongoOptions "go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/repositories"
@@ -18,6 +20,9 @@ import (
 
 const (
 	restaurantCollection = "restaurant"
+
+	// earthRadiusMeters is used to convert distance in meters to radians
+	earthRadiusMeters = 6378100
 )
 
 type restaurantRepository struct {
@@ -34,6 +39,8 @@ func (db *restaurantRepository) Create(ctx context.Context,
 	restaurant restaurant.Restaurant) (restaurant.Restaurant, errors.AppError) {
 
 	restaurant.ID = ""
+	restaurant.ScheduleExceptions = nil
+	restaurant.Members = nil
 	restaurant.CreatedAt = time.Now()
 	restaurant.UpdatedAt = time.Now()
 	restaurant.Address.Location.Type = "Point"
@@ -72,8 +79,7 @@ func (db *restaurantRepository) GetByID(ctx context.Context, restaurantID string
 
 	cursor, findError := collection.Find(findCtx, filter)
 	if findError != nil {
-		return restaurant.Restaurant{}, errors.NewAppError("Something went wrong",
-			http.StatusInternalServerError, findError)
+		return restaurant.Restaurant{}, operationError(findError)
 	}
 
 	cursorCtx, cursorCancel := context.WithCancel(ctx)
@@ -82,13 +88,120 @@ func (db *restaurantRepository) GetByID(ctx context.Context, restaurantID string
 	if cursor.Next(cursorCtx) {
 		decodeError := cursor.Decode(&restaurantObj)
 		if decodeError != nil {
-			return restaurant.Restaurant{}, errors.NewAppError("Something went wrong",
-				http.StatusInternalServerError, decodeError)
+			return restaurant.Restaurant{}, operationError(decodeError)
 		}
 	}
 	return restaurantObj, nil
 }
 
+func (db *restaurantRepository) GetByIDs(ctx context.Context,
+	restaurantIDs []string) ([]restaurant.Restaurant, errors.AppError) {
+
+	restaurants := []restaurant.Restaurant{}
+	findCtx, findCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer findCancel()
+
+	// invalid ids can't match any restaurant so they are skipped
+	objectIDs := []primitive.ObjectID{}
+	for _, restaurantID := range restaurantIDs {
+		objectID, convertError := primitive.ObjectIDFromHex(restaurantID)
+		if convertError == nil {
+			objectIDs = append(objectIDs, objectID)
+		}
+	}
+	filter := bson.D{
+		{
+			Key: "_id",
+			Value: bson.D{
+				{Key: "$in", Value: objectIDs},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	cursor, findError := collection.Find(findCtx, filter)
+	if findError != nil {
+		return restaurants, operationError(findError)
+	}
+
+	cursorCtx, cursorCancel := context.WithCancel(ctx)
+	defer cursorCancel()
+
+	for cursor.Next(cursorCtx) {
+		var restaurantObj restaurant.Restaurant
+		decodeError := cursor.Decode(&restaurantObj)
+		if decodeError != nil {
+			return restaurants, operationError(decodeError)
+		}
+		restaurants = append(restaurants, restaurantObj)
+	}
+	return restaurants, nil
+}
+
+func (db *restaurantRepository) Update(ctx context.Context,
+	restaurantObj restaurant.Restaurant) (restaurant.Restaurant, errors.AppError) {
+
+	restaurantID := restaurantObj.ID
+	scheduleExceptions := restaurantObj.ScheduleExceptions
+	state := restaurantObj.State
+	stateHistory := restaurantObj.StateHistory
+	members := restaurantObj.Members
+	reviewsRatingSum := restaurantObj.ReviewsRatingSum
+	reviewsCount := restaurantObj.ReviewsCount
+	// schedule exceptions, state, members and review counters are updated separately, stale values
+	// read before the update are not written back
+	restaurantObj.ID = ""
+	restaurantObj.ScheduleExceptions = nil
+	restaurantObj.State = ""
+	restaurantObj.StateHistory = nil
+	restaurantObj.Members = nil
+	restaurantObj.ReviewsRatingSum = 0
+	restaurantObj.ReviewsCount = 0
+	restaurantObj.UpdatedAt = time.Now()
+	restaurantObj.Address.Location.Type = "Point"
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	objectID, _ := primitive.ObjectIDFromHex(restaurantID)
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: objectID,
+		},
+	}
+	update := bson.D{
+		{
+			Key:   "$set",
+			Value: restaurantObj,
+		},
+	}
+	// omitted open override is not removed by $set, removing the stored one explicitly
+	if restaurantObj.OpenOverride == nil {
+		update = append(update, bson.E{
+			Key: "$unset",
+			Value: bson.D{
+				{Key: "open_override", Value: ""},
+			},
+		})
+	}
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	_, updateError := collection.UpdateOne(updateCtx, filter, update)
+	restaurantObj.ID = restaurantID
+	restaurantObj.ScheduleExceptions = scheduleExceptions
+	restaurantObj.State = state
+	restaurantObj.StateHistory = stateHistory
+	restaurantObj.Members = members
+	restaurantObj.ReviewsRatingSum = reviewsRatingSum
+	restaurantObj.ReviewsCount = reviewsCount
+	if updateError != nil {
+		return restaurantObj, operationError(updateError)
+	}
+	return restaurantObj, nil
+}
+
 func (db *restaurantRepository) DeleteByID(ctx context.Context,
 	restaurantID string) errors.AppError {
 
@@ -107,38 +220,145 @@ func (db *restaurantRepository) DeleteByID(ctx context.Context,
 
 	_, deleteErr := collection.DeleteOne(deleteCtx, filter)
 	if deleteErr != nil {
-		return errors.NewAppError("Something went wrong", http.StatusInternalServerError, deleteErr)
+		return operationError(deleteErr)
 	}
 	return nil
 }
 
-func (db *restaurantRepository) GetAllRestaurants(ctx context.Context,
-	query restaurantUsecase.GetAllRestaurantsRequest,
-	maxDistance int64) ([]restaurant.Restaurant, errors.AppError) {
+func (db *restaurantRepository) UpdateState(ctx context.Context, restaurantID string,
+	transition restaurant.StateTransition) errors.AppError {
 
-	restaurants := []restaurant.Restaurant{}
-	offset := (query.PageNumber - 1) * query.PageSize
-	findCtx, findCancel := context.WithTimeout(ctx, 1*time.Second)
-	defer findCancel()
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
 
-	findOptions := &mongoOptions.FindOptions{
-		Skip:  &offset,
-		Limit: &query.PageSize,
+	// state is updated only if it is not changed since it was read
+	objectID, _ := primitive.ObjectIDFromHex(restaurantID)
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: objectID,
+		},
+		getStateFilter(transition.From),
+	}
+	update := bson.D{
+		{
+			Key: "$set",
+			Value: bson.D{
+				{Key: "state", Value: transition.To},
+				{Key: "updated_at", Value: transition.CreatedAt},
+			},
+		},
+		{
+			Key: "$push",
+			Value: bson.D{
+				{Key: "state_history", Value: transition},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	updateResult, updateError := collection.UpdateOne(updateCtx, filter, update)
+	if updateError != nil {
+		return operationError(updateError)
+	}
+	if updateResult.MatchedCount == 0 {
+		return errors.NewAppError("Restaurant state has been changed, please retry", http.StatusConflict, nil)
 	}
+	return nil
+}
 
+func (db *restaurantRepository) AddScheduleException(ctx context.Context, restaurantID string,
+	exception restaurant.ScheduleException) (restaurant.ScheduleException, errors.AppError) {
+
+	exception.ID = primitive.NewObjectID().Hex()
+	exception.CreatedAt = time.Now()
+	exception.UpdatedAt = time.Now()
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	objectID, _ := primitive.ObjectIDFromHex(restaurantID)
 	filter := bson.D{
 		{
-			Key: "address.location",
+			Key:   "_id",
+			Value: objectID,
+		},
+	}
+	update := bson.D{
+		{
+			Key: "$push",
+			Value: bson.D{
+				{Key: "schedule_exceptions", Value: exception},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	_, updateError := collection.UpdateOne(updateCtx, filter, update)
+	if updateError != nil {
+		return exception, operationError(updateError)
+	}
+	return exception, nil
+}
+
+func (db *restaurantRepository) UpdateScheduleException(ctx context.Context, restaurantID string,
+	exception restaurant.ScheduleException) (restaurant.ScheduleException, errors.AppError) {
+
+	exception.UpdatedAt = time.Now()
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	objectID, _ := primitive.ObjectIDFromHex(restaurantID)
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: objectID,
+		},
+		{
+			Key:   "schedule_exceptions._id",
+			Value: exception.ID,
+		},
+	}
+	update := bson.D{
+		{
+			Key: "$set",
+			Value: bson.D{
+				{Key: "schedule_exceptions.$", Value: exception},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	_, updateError := collection.UpdateOne(updateCtx, filter, update)
+	if updateError != nil {
+		return exception, operationError(updateError)
+	}
+	return exception, nil
+}
+
+func (db *restaurantRepository) DeleteScheduleException(ctx context.Context, restaurantID string,
+	exceptionID string) errors.AppError {
+
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	objectID, _ := primitive.ObjectIDFromHex(restaurantID)
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: objectID,
+		},
+	}
+	update := bson.D{
+		{
+			Key: "$pull",
 			Value: bson.D{
 				{
-					Key: "$geoWithin",
+					Key: "schedule_exceptions",
 					Value: bson.D{
-						{
-							Key: "$centerSphere",
-							Value: bson.A{
-								bson.A{query.Longitude, query.Latitude},
-								maxDistance},
-						},
+						{Key: "_id", Value: exceptionID},
 					},
 				},
 			},
@@ -147,18 +367,139 @@ func (db *restaurantRepository) GetAllRestaurants(ctx context.Context,
 
 	collection := db.Database(db.database).Collection(restaurantCollection)
 
-	cursor, findError := collection.Find(findCtx, filter, findOptions)
-	if findError != nil {
-		return restaurants, errors.NewAppError("Something went wrong", http.StatusInternalServerError, findError)
+	_, updateError := collection.UpdateOne(updateCtx, filter, update)
+	if updateError != nil {
+		return operationError(updateError)
+	}
+	return nil
+}
+
+func (db *restaurantRepository) AddMember(ctx context.Context, restaurantID string,
+	member restaurant.Member) errors.AppError {
+
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	// member is added only if user is not already a member of the restaurant
+	objectID, _ := primitive.ObjectIDFromHex(restaurantID)
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: objectID,
+		},
+		{
+			Key: "members.user_id",
+			Value: bson.D{
+				{Key: "$ne", Value: member.UserID},
+			},
+		},
+	}
+	update := bson.D{
+		{
+			Key: "$push",
+			Value: bson.D{
+				{Key: "members", Value: member},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	updateResult, updateError := collection.UpdateOne(updateCtx, filter, update)
+	if updateError != nil {
+		return operationError(updateError)
+	}
+	if updateResult.MatchedCount == 0 {
+		return errors.NewAppError("User is already a member of the restaurant", http.StatusConflict, nil)
+	}
+	return nil
+}
+
+func (db *restaurantRepository) DeleteMember(ctx context.Context, restaurantID string,
+	userID string) errors.AppError {
+
+	updateCtx, updateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer updateCancel()
+
+	objectID, _ := primitive.ObjectIDFromHex(restaurantID)
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: objectID,
+		},
+	}
+	update := bson.D{
+		{
+			Key: "$pull",
+			Value: bson.D{
+				{
+					Key: "members",
+					Value: bson.D{
+						{Key: "user_id", Value: userID},
+					},
+				},
+			},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	_, updateError := collection.UpdateOne(updateCtx, filter, update)
+	if updateError != nil {
+		return operationError(updateError)
+	}
+	return nil
+}
+
+func (db *restaurantRepository) GetAllRestaurants(ctx context.Context,
+	query restaurantUsecase.GetAllRestaurantsRequest) ([]restaurantUsecase.NearbyRestaurant, errors.AppError) {
+
+	restaurants := []restaurantUsecase.NearbyRestaurant{}
+	offset := (query.PageNumber - 1) * query.PageSize
+	aggregateCtx, aggregateCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer aggregateCancel()
+
+	// $geoNear returns the restaurants sorted by distance from the provided point
+	geoNear := bson.D{
+		{
+			Key: "$geoNear",
+			Value: bson.D{
+				{
+					Key: "near",
+					Value: bson.D{
+						{Key: "type", Value: "Point"},
+						{Key: "coordinates", Value: bson.A{query.Longitude, query.Latitude}},
+					},
+				},
+				{Key: "key", Value: "address.location"},
+				{Key: "distanceField", Value: "distance_meters"},
+				{Key: "maxDistance", Value: query.Radius},
+				{Key: "spherical", Value: true},
+				{Key: "query", Value: bson.D{getStateFilter(query.State)}},
+			},
+		},
+	}
+	skip := bson.D{
+		{Key: "$skip", Value: offset},
+	}
+	limit := bson.D{
+		{Key: "$limit", Value: query.PageSize},
+	}
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	cursor, aggregateError := collection.Aggregate(aggregateCtx, mongoDriver.Pipeline{geoNear, skip, limit})
+	if aggregateError != nil {
+		return restaurants, operationError(aggregateError)
 	}
 
 	cursorCtx, cursorCancel := context.WithCancel(ctx)
 	defer cursorCancel()
 	for cursor.Next(cursorCtx) {
-		var restaurantObj restaurant.Restaurant
+		var restaurantObj restaurantUsecase.NearbyRestaurant
 		decodeError := cursor.Decode(&restaurantObj)
 		if decodeError != nil {
-			return restaurants, errors.NewAppError("Something went wrong", http.StatusInternalServerError, decodeError)
+			return restaurants, operationError(decodeError)
 		}
 		restaurants = append(restaurants, restaurantObj)
 	}
@@ -166,11 +507,13 @@ func (db *restaurantRepository) GetAllRestaurants(ctx context.Context,
 }
 
 func (db *restaurantRepository) GetAllRestaurantsTotalCount(ctx context.Context,
-	query restaurantUsecase.GetAllRestaurantsRequest, maxDistance int64) (int64, errors.AppError) {
+	query restaurantUsecase.GetAllRestaurantsRequest) (int64, errors.AppError) {
 
 	countCtx, countCancel := context.WithTimeout(ctx, 1*time.Second)
 	defer countCancel()
 
+	// $nearSphere is not supported while counting documents, $centerSphere
+	// covers the same area but expects the radius in radians
 	filter := bson.D{
 		{
 			Key: "address.location",
@@ -182,20 +525,138 @@ func (db *restaurantRepository) GetAllRestaurantsTotalCount(ctx context.Context,
 							Key: "$centerSphere",
 							Value: bson.A{
 								bson.A{query.Longitude, query.Latitude},
-								maxDistance},
+								query.Radius / earthRadiusMeters},
 						},
 					},
 				},
 			},
 		},
+		getStateFilter(query.State),
 	}
 
 	collection := db.Database(db.database).Collection(restaurantCollection)
 
 	totalCount, findError := collection.CountDocuments(countCtx, filter)
 	if findError != nil {
-		return totalCount, errors.NewAppError("Something went wrong", http.StatusInternalServerError, findError)
+		return totalCount, operationError(findError)
+	}
+
+	return totalCount, nil
+}
+
+func (db *restaurantRepository) SearchRestaurants(ctx context.Context,
+	query restaurantUsecase.SearchRestaurantsRequest) ([]restaurant.Restaurant, errors.AppError) {
+
+	restaurants := []restaurant.Restaurant{}
+	offset := (query.PageNumber - 1) * query.PageSize
+	findCtx, findCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer findCancel()
+
+	findOptions := &mongoOptions.FindOptions{
+		Skip:  &offset,
+		Limit: &query.PageSize,
+		Sort: bson.D{
+			{Key: "created_at", Value: -1},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	cursor, findError := collection.Find(findCtx, getSearchFilter(query), findOptions)
+	if findError != nil {
+		return restaurants, operationError(findError)
+	}
+
+	cursorCtx, cursorCancel := context.WithCancel(ctx)
+	defer cursorCancel()
+	for cursor.Next(cursorCtx) {
+		var restaurantObj restaurant.Restaurant
+		decodeError := cursor.Decode(&restaurantObj)
+		if decodeError != nil {
+			return restaurants, operationError(decodeError)
+		}
+		restaurants = append(restaurants, restaurantObj)
+	}
+	return restaurants, nil
+}
+
+func (db *restaurantRepository) SearchRestaurantsTotalCount(ctx context.Context,
+	query restaurantUsecase.SearchRestaurantsRequest) (int64, errors.AppError) {
+
+	countCtx, countCancel := context.WithTimeout(ctx, 1*time.Second)
+	defer countCancel()
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	totalCount, findError := collection.CountDocuments(countCtx, getSearchFilter(query))
+	if findError != nil {
+		return totalCount, operationError(findError)
 	}
 
 	return totalCount, nil
 }
+
+func (db *restaurantRepository) CreateIndexes(ctx context.Context) errors.AppError {
+
+	indexCtx, indexCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer indexCancel()
+
+	// $geoNear requires geospatial index on the location
+	locationIndex := mongoDriver.IndexModel{
+		Keys: bson.D{
+			{Key: "address.location", Value: "2dsphere"},
+		},
+	}
+
+	collection := db.Database(db.database).Collection(restaurantCollection)
+
+	_, indexError := collection.Indexes().CreateOne(indexCtx, locationIndex)
+	if indexError != nil {
+		return operationError(indexError)
+	}
+	return nil
+}
+
+// getStateFilter returns the filter to match restaurants in the provided state. Restaurants
+// created before the lifecycle states were introduced don't have the state and are live
+func getStateFilter(state restaurant.State) bson.E {
+	if state == restaurant.StateLive {
+		return bson.E{
+			Key: "state",
+			Value: bson.D{
+				{Key: "$in", Value: bson.A{state, nil}},
+			},
+		}
+	}
+	return bson.E{Key: "state", Value: state}
+}
+
+// getSearchFilter returns the filter for the restaurant search query
+func getSearchFilter(query restaurantUsecase.SearchRestaurantsRequest) bson.D {
+	filter := bson.D{}
+	if query.MerchantID != "" {
+		filter = append(filter, bson.E{Key: "merchant_id", Value: query.MerchantID})
+	}
+	if query.State != "" {
+		filter = append(filter, getStateFilter(query.State))
+	}
+	if query.Name != "" {
+		filter = append(filter, bson.E{
+			Key: "name",
+			Value: primitive.Regex{
+				Pattern: regexp.QuoteMeta(query.Name),
+				Options: "i",
+			},
+		})
+	}
+	if query.City != "" {
+		filter = append(filter, bson.E{
+			Key: "address.city",
+			Value: primitive.Regex{
+				Pattern: "^" + regexp.QuoteMeta(query.City) + "$",
+				Options: "i",
+			},
+		})
+	}
+	return filter
+}
@@ -0,0 +1,102 @@
+package mongo
+
+import (
+	"context"
+	"net/http"
+
+	mongoDriver "go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/repositories"
+	"github.com/dhyaniarun1993/foody-common/datastore/mongo"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+const (
+	// transactionAttempts is the number of times the transaction, or its commit, is attempted
+	transactionAttempts = 3
+
+	transientTransactionErrorLabel = "TransientTransactionError"
+	unknownCommitResultErrorLabel  = "UnknownTransactionCommitResult"
+)
+
+// transactionKey marks the context of the work running in a transaction
+type transactionKey struct{}
+
+// transientError marks the error of the operation which failed due to the concurrent transaction
+// or a transient failure of the server, unit of work which fails with it is retried
+type transientError struct {
+	errors.AppError
+}
+
+// operationError returns the error of the failed database operation
+func operationError(err error) errors.AppError {
+	if hasErrorLabel(err, transientTransactionErrorLabel) {
+		return transientError{errors.NewAppError("Resource is being updated concurrently, please retry",
+			http.StatusConflict, err)}
+	}
+	return errors.NewAppError("Something went wrong", http.StatusInternalServerError, err)
+}
+
+// hasErrorLabel checks if the server labeled the error with the label
+func hasErrorLabel(err error, label string) bool {
+	commandError, ok := err.(mongoDriver.CommandError)
+	return ok && commandError.HasErrorLabel(label)
+}
+
+type unitOfWork struct {
+	*mongo.Client
+}
+
+// NewUnitOfWork creates and return unit of work backed by mongodb transactions.
+// Transactions require mongodb to be deployed as a replica set
+func NewUnitOfWork(mongoClient *mongo.Client) repositories.UnitOfWork {
+	return &unitOfWork{mongoClient}
+}
+
+// Do runs the work in a transaction, repository operations performed with the context passed to
+// the work are committed together or not at all. Work which is already part of a unit of work
+// joins the outer transaction. Work which fails due to a concurrent transaction is run again,
+// so it should only change the state outside of the transaction which it sets again
+func (db *unitOfWork) Do(ctx context.Context, work func(ctx context.Context) errors.AppError) errors.AppError {
+	if ctx.Value(transactionKey{}) != nil {
+		return work(ctx)
+	}
+
+	for attempt := 1; ; attempt++ {
+		workError := db.run(ctx, work)
+		if _, transient := workError.(transientError); !transient || attempt == transactionAttempts {
+			return workError
+		}
+	}
+}
+
+// run runs the work in a single transaction
+func (db *unitOfWork) run(ctx context.Context, work func(ctx context.Context) errors.AppError) errors.AppError {
+	// session is carried by the context, so the repositories take part in the transaction
+	// through the timeouts they derive from it
+	var workError errors.AppError
+	sessionError := db.UseSession(ctx, func(sessionCtx mongoDriver.SessionContext) error {
+		transactionError := sessionCtx.StartTransaction()
+		if transactionError != nil {
+			return transactionError
+		}
+
+		workError = work(context.WithValue(sessionCtx, transactionKey{}, true))
+		if workError != nil {
+			sessionCtx.AbortTransaction(sessionCtx)
+			return nil
+		}
+
+		// commit can be retried when it is unknown if the transaction was committed
+		commitError := sessionCtx.CommitTransaction(sessionCtx)
+		for attempt := 1; attempt < transactionAttempts &&
+			hasErrorLabel(commitError, unknownCommitResultErrorLabel); attempt++ {
+			commitError = sessionCtx.CommitTransaction(sessionCtx)
+		}
+		return commitError
+	})
+	if sessionError != nil {
+		return operationError(sessionError)
+	}
+	return workError
+}
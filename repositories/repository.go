@@ -2,14 +2,21 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/category"
 	"github.com/dhyaniarun1993/foody-catalog-service/product"
+	productUsecase "github.com/dhyaniarun1993/foody-catalog-service/product/usecase"
 	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
 	restaurantUsecase "github.com/dhyaniarun1993/foody-catalog-service/restaurant/usecase"
 	"github.com/dhyaniarun1993/foody-common/errors"
 )
 
+// UnitOfWork provides interface to perform operations of multiple repositories atomically
+type UnitOfWork interface {
+	Do(ctx context.Context, work func(ctx context.Context) errors.AppError) errors.AppError
+}
+
 // HealthRepository provides interface for Health repositories
 type HealthRepository interface {
 	HealthCheck(context.Context) errors.AppError
@@ -19,29 +26,73 @@ type HealthRepository interface {
 type RestaurantRepository interface {
 	Create(ctx context.Context, restaurant restaurant.Restaurant) (restaurant.Restaurant, errors.AppError)
 	GetByID(ctx context.Context, restaurantID string) (restaurant.Restaurant, errors.AppError)
+	GetByIDs(ctx context.Context, restaurantIDs []string) ([]restaurant.Restaurant, errors.AppError)
+	Update(ctx context.Context, restaurant restaurant.Restaurant) (restaurant.Restaurant, errors.AppError)
 	DeleteByID(ctx context.Context, restaurantID string) errors.AppError
-	GetAllRestaurants(context.Context, restaurantUsecase.GetAllRestaurantsRequest,
-		int64) ([]restaurant.Restaurant, errors.AppError)
-	GetAllRestaurantsTotalCount(context.Context, restaurantUsecase.GetAllRestaurantsRequest,
-		int64) (int64, errors.AppError)
+	UpdateState(ctx context.Context, restaurantID string, transition restaurant.StateTransition) errors.AppError
+	AddScheduleException(ctx context.Context, restaurantID string,
+		exception restaurant.ScheduleException) (restaurant.ScheduleException, errors.AppError)
+	UpdateScheduleException(ctx context.Context, restaurantID string,
+		exception restaurant.ScheduleException) (restaurant.ScheduleException, errors.AppError)
+	DeleteScheduleException(ctx context.Context, restaurantID string, exceptionID string) errors.AppError
+	AddMember(ctx context.Context, restaurantID string, member restaurant.Member) errors.AppError
+	DeleteMember(ctx context.Context, restaurantID string, userID string) errors.AppError
+	GetAllRestaurants(context.Context,
+		restaurantUsecase.GetAllRestaurantsRequest) ([]restaurantUsecase.NearbyRestaurant, errors.AppError)
+	GetAllRestaurantsTotalCount(context.Context, restaurantUsecase.GetAllRestaurantsRequest) (int64, errors.AppError)
+	SearchRestaurants(context.Context,
+		restaurantUsecase.SearchRestaurantsRequest) ([]restaurant.Restaurant, errors.AppError)
+	SearchRestaurantsTotalCount(context.Context, restaurantUsecase.SearchRestaurantsRequest) (int64, errors.AppError)
+	CreateIndexes(ctx context.Context) errors.AppError
 }
 
 // ProductRepository provides interface for Product repository
 type ProductRepository interface {
 	CreateProduct(ctx context.Context, product product.Product) (product.Product, errors.AppError)
 	CreateVariant(ctx context.Context, variant product.Variant) (product.Variant, errors.AppError)
+	UpdateProduct(ctx context.Context, product product.Product) (product.Product, errors.AppError)
+	UpdateVariant(ctx context.Context, variant product.Variant) (product.Variant, errors.AppError)
 	GetProductByID(ctx context.Context, productID string) (product.Product, errors.AppError)
 	GetVariantByID(ctx context.Context, variantID string) (product.Variant, errors.AppError)
+	GetProductsByIDs(ctx context.Context, productIDs []string) ([]product.Product, errors.AppError)
+	GetCombosByComponentIDs(ctx context.Context, productIDs []string) ([]product.Product, errors.AppError)
+	GetAllProducts(ctx context.Context,
+		request productUsecase.GetAllProductsRequest) ([]product.Product, errors.AppError)
+	GetAllProductsTotalCount(ctx context.Context, request productUsecase.GetAllProductsRequest) (int64, errors.AppError)
 	DeleteProductByID(ctx context.Context, productID string) errors.AppError
-	DeleteVariantByID(ctx context.Context, variantID string) errors.AppError
+	DeleteVariantByID(ctx context.Context, productID string, variantID string) errors.AppError
+	UpdateProductStock(ctx context.Context, productID string, stockOverride *bool, inStock bool) errors.AppError
+	UpdateVariantStock(ctx context.Context, variantID string, inStock bool) errors.AppError
+	SetVariantQuantity(ctx context.Context, variantID string, quantity int64) errors.AppError
+	AdjustVariantQuantity(ctx context.Context, variantID string, delta int64) (product.Variant, errors.AppError)
+	ResetDailyStock(ctx context.Context, now time.Time) ([]string, errors.AppError)
+	BulkUpdateStock(ctx context.Context, items []productUsecase.StockItem) ([]bool, errors.AppError)
+	SyncProductStock(ctx context.Context, products []product.Product) errors.AppError
 	DeleteProductByRestaurantID(ctx context.Context, restaurantID string) errors.AppError
 	DeleteProductByCategoryID(ctx context.Context, categoryID string) errors.AppError
+	CountCombosByComponentCategoryIDs(ctx context.Context, categoryIDs []string) (int64, errors.AppError)
+	MigrateStockOverride(ctx context.Context) errors.AppError
+}
+
+// ReservationRepository provides interface for Reservation repository
+type ReservationRepository interface {
+	CreateReservation(ctx context.Context, reservation product.Reservation) (product.Reservation, errors.AppError)
+	GetReservationByOrderID(ctx context.Context, orderID string) (product.Reservation, errors.AppError)
+	GetExpiredReservations(ctx context.Context, now time.Time, limit int64) ([]product.Reservation, errors.AppError)
+	CloseReservation(ctx context.Context, orderID string, status string,
+		heldUntil time.Time) (product.Reservation, errors.AppError)
+	DeleteReservationByID(ctx context.Context, reservationID string) errors.AppError
+	CreateIndexes(ctx context.Context, retention time.Duration) errors.AppError
 }
 
 // CategoryRepository provides interface for Category repository
 type CategoryRepository interface {
 	Create(ctx context.Context, category category.Category) (category.Category, errors.AppError)
 	GetByID(ctx context.Context, categoryID string) (category.Category, errors.AppError)
+	Update(ctx context.Context, category category.Category) (category.Category, errors.AppError)
+	GetByRestaurantID(ctx context.Context, restaurantID string) ([]category.Category, errors.AppError)
+	Reorder(ctx context.Context, restaurantID string, categoryIDs []string) errors.AppError
 	DeleteByID(ctx context.Context, categoryID string) errors.AppError
 	DeleteByRestaurantID(ctx context.Context, restaurantID string) errors.AppError
+	GetMenuByRestaurantID(ctx context.Context, restaurantID string) ([]category.Category, errors.AppError)
 }
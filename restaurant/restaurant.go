@@ -37,19 +37,168 @@ type GeoJSON struct {
 	Coordinates []float64 `bson:"coordinates" json:"coordinates" validate:"required,min=2,max=2"`
 }
 
-// Restaurant provides the model definition for Restaurant
+// TimeSlot provides the schema definition for a time slot in which restaurant is open.
+// Opens and Closes are in 24 hour "HH:MM" format, slot closing at or before the opening
+// time is considered to close on the next day
+type TimeSlot struct {
+	Day    string `bson:"day" json:"day" validate:"required,oneof=sunday monday tuesday wednesday thursday friday saturday"`
+	Opens  string `bson:"opens" json:"opens" validate:"required"`
+	Closes string `bson:"closes" json:"closes" validate:"required"`
+}
+
+// Weekday returns the day of the week of the time slot
+func (slot TimeSlot) Weekday() time.Weekday {
+	return weekdays[slot.Day]
+}
+
+// Hours provides the schema definition for opening hours of a day in "HH:MM" format
+type Hours struct {
+	Opens  string `bson:"opens" json:"opens" validate:"required"`
+	Closes string `bson:"closes" json:"closes" validate:"required"`
+}
+
+// ScheduleException provides the model definition for one-off closure or special opening
+// hours of the restaurant. Dates are inclusive and in "YYYY-MM-DD" format, restaurant
+// is closed for the whole range if no special hours are provided
+type ScheduleException struct {
+	ID           string    `bson:"_id" json:"id"`
+	StartDate    string    `bson:"start_date" json:"start_date" validate:"required"`
+	EndDate      string    `bson:"end_date" json:"end_date" validate:"required"`
+	Reason       string    `bson:"reason" json:"reason" validate:"required,max=120"`
+	SpecialHours []Hours   `bson:"special_hours" json:"special_hours" validate:"dive"`
+	CreatedAt    time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// Covers checks if the exception applies on the date provided in "YYYY-MM-DD" format
+func (exception ScheduleException) Covers(date string) bool {
+	return exception.StartDate <= date && date <= exception.EndDate
+}
+
+// Validate validates ScheduleException schema
+func (exception ScheduleException) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	// validate struct data
+	err := validate.Struct(exception)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("Invalid value for field '%s'", err.Field())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+
+	startDate, startDateError := time.Parse(DateFormat, exception.StartDate)
+	if startDateError != nil {
+		return errors.NewAppError("Invalid value for field 'StartDate'", http.StatusBadRequest, startDateError)
+	}
+	endDate, endDateError := time.Parse(DateFormat, exception.EndDate)
+	if endDateError != nil {
+		return errors.NewAppError("Invalid value for field 'EndDate'", http.StatusBadRequest, endDateError)
+	}
+	if endDate.Before(startDate) {
+		return errors.NewAppError("End date cannot be before start date", http.StatusBadRequest, nil)
+	}
+
+	for _, hours := range exception.SpecialHours {
+		_, opensError := ParseClock(hours.Opens)
+		if opensError != nil {
+			return errors.NewAppError("Invalid value for field 'Opens'", http.StatusBadRequest, opensError)
+		}
+		_, closesError := ParseClock(hours.Closes)
+		if closesError != nil {
+			return errors.NewAppError("Invalid value for field 'Closes'", http.StatusBadRequest, closesError)
+		}
+	}
+	return nil
+}
+
+// OpenOverride provides the schema definition for manual override of the restaurant
+// open state. Override takes precedence over the opening hours till it expires
+type OpenOverride struct {
+	IsOpen bool      `bson:"is_open" json:"is_open"`
+	Until  time.Time `bson:"until" json:"until" validate:"required"`
+}
+
+// State provides the lifecycle state of the Restaurant
+type State string
+
+// Restaurant lifecycle states
+const (
+	StateDraft           State = "draft"
+	StatePendingApproval State = "pending_approval"
+	StateLive            State = "live"
+	StatePaused          State = "paused"
+	StateSuspended       State = "suspended"
+	StateArchived        State = "archived"
+)
+
+// StateTransition provides the schema definition for change in lifecycle state of the restaurant
+type StateTransition struct {
+	From      State     `bson:"from,omitempty" json:"from,omitempty"`
+	To        State     `bson:"to" json:"to"`
+	ActorID   string    `bson:"actor_id" json:"actor_id"`
+	ActorRole string    `bson:"actor_role" json:"actor_role"`
+	Reason    string    `bson:"reason,omitempty" json:"reason,omitempty"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// MemberRole provides the role of the staff member in the restaurant
+type MemberRole string
+
+// Restaurant member roles
+const (
+	MemberRoleOwner     MemberRole = "owner"
+	MemberRoleManager   MemberRole = "manager"
+	MemberRoleStockOnly MemberRole = "stock_only"
+)
+
+// Member provides the schema definition for staff member of the restaurant
+type Member struct {
+	UserID    string     `bson:"user_id" json:"user_id" validate:"required"`
+	Role      MemberRole `bson:"role" json:"role" validate:"required,oneof=owner manager stock_only"`
+	InvitedBy string     `bson:"invited_by" json:"invited_by"`
+	CreatedAt time.Time  `bson:"created_at" json:"created_at"`
+}
+
+// Validate validates Member schema
+func (member Member) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	// validate struct data
+	err := validate.Struct(member)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("Invalid value for field '%s'", err.Field())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+// Restaurant provides the model definition for Restaurant. Schedule exceptions,
+// lifecycle state and members are managed through their own endpoints
 type Restaurant struct {
-	ID               string    `bson:"_id,omitempty" json:"id"`
-	MerchantID       string    `bson:"merchant_id" json:"merchant_id" validate:"required"`
-	Name             string    `bson:"name" json:"name" validate:"required,min=2,max=30"`
-	Description      string    `bson:"description" json:"description" validate:"max=120"`
-	ReviewsRatingSum int64     `bson:"reviews_rating_sum" json:"reviews_rating_sum"`
-	ReviewsCount     int64     `bson:"reviews_count" json:"reviews_count"`
-	Address          Address   `bson:"address" json:"address" validate:"required,dive"`
-	RestaurantFees   Fees      `bson:"restaurant_fees" json:"restaurant_fees" validate:"required,dive"`
-	IsOpen           bool      `bson:"is_open" json:"is_open"`
-	CreatedAt        time.Time `bson:"created_at" json:"created_at"`
-	UpdatedAt        time.Time `bson:"updated_at" json:"updated_at"`
+	ID                 string              `bson:"_id,omitempty" json:"id"`
+	MerchantID         string              `bson:"merchant_id" json:"merchant_id" validate:"required"`
+	Name               string              `bson:"name" json:"name" validate:"required,min=2,max=30"`
+	Description        string              `bson:"description" json:"description" validate:"max=120"`
+	ReviewsRatingSum   int64               `bson:"reviews_rating_sum,omitempty" json:"reviews_rating_sum"`
+	ReviewsCount       int64               `bson:"reviews_count,omitempty" json:"reviews_count"`
+	Address            Address             `bson:"address" json:"address" validate:"required,dive"`
+	RestaurantFees     Fees                `bson:"restaurant_fees" json:"restaurant_fees" validate:"required,dive"`
+	TimeZone           string              `bson:"time_zone" json:"time_zone"`
+	OpeningHours       []TimeSlot          `bson:"opening_hours" json:"opening_hours" validate:"dive"`
+	OpenOverride       *OpenOverride       `bson:"open_override,omitempty" json:"open_override,omitempty" validate:"omitempty,dive"`
+	ScheduleExceptions []ScheduleException `bson:"schedule_exceptions,omitempty" json:"schedule_exceptions"`
+	State              State               `bson:"state,omitempty" json:"state"`
+	StateHistory       []StateTransition   `bson:"state_history,omitempty" json:"state_history,omitempty"`
+	Members            []Member            `bson:"members,omitempty" json:"-"`
+	IsOpen             bool                `bson:"-" json:"is_open"`
+	NextOpensAt        *time.Time          `bson:"-" json:"next_opens_at,omitempty"`
+	ClosesAt           *time.Time          `bson:"-" json:"closes_at,omitempty"`
+	CreatedAt          time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt          time.Time           `bson:"updated_at" json:"updated_at"`
 }
 
 // Validate validates Restaurant schema
@@ -77,5 +226,45 @@ func (restaurant Restaurant) Validate(validate *validator.Validate) errors.AppEr
 
 		return errors.NewAppError("Invalid value for field latitude", http.StatusBadRequest, err)
 	}
+
+	// validate time zone, restaurant without time zone is in UTC
+	_, locationError := time.LoadLocation(restaurant.TimeZone)
+	if locationError != nil {
+		return errors.NewAppError("Invalid value for field 'TimeZone'", http.StatusBadRequest, locationError)
+	}
+
+	// validate opening hours
+	for _, slot := range restaurant.OpeningHours {
+		_, opensError := ParseClock(slot.Opens)
+		if opensError != nil {
+			return errors.NewAppError("Invalid value for field 'Opens'", http.StatusBadRequest, opensError)
+		}
+		_, closesError := ParseClock(slot.Closes)
+		if closesError != nil {
+			return errors.NewAppError("Invalid value for field 'Closes'", http.StatusBadRequest, closesError)
+		}
+	}
 	return nil
 }
+
+// DateFormat is the layout of the dates used in the restaurant schedule
+const DateFormat = "2006-01-02"
+
+// ParseClock parses time of the day in "HH:MM" format and returns its offset from the midnight
+func ParseClock(clock string) (time.Duration, error) {
+	clockTime, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(clockTime.Hour())*time.Hour + time.Duration(clockTime.Minute())*time.Minute, nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
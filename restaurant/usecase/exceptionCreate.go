@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *restaurantInteractor) CreateScheduleException(ctx context.Context, auth authentication.Auth,
+	restaurantID string, exception restaurant.ScheduleException) (restaurant.ScheduleException, errors.AppError) {
+
+	validationError := exception.Validate(interactor.validator)
+	if validationError != nil {
+		return restaurant.ScheduleException{}, validationError
+	}
+
+	restaurantObj, getError := interactor.GetByID(ctx, auth, restaurantID)
+	if getError != nil {
+		return restaurant.ScheduleException{}, getError
+	}
+
+	// check if user have access to update the restaurant schedule
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite, Resource(restaurantObj)).Allowed {
+
+		var repositoryError errors.AppError
+		exception, repositoryError = interactor.restaurantRepository.AddScheduleException(ctx,
+			restaurantID, exception)
+		return exception, repositoryError
+	}
+	return restaurant.ScheduleException{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
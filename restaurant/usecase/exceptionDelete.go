@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *restaurantInteractor) DeleteScheduleException(ctx context.Context, auth authentication.Auth,
+	restaurantID string, exceptionID string) errors.AppError {
+
+	restaurantObj, getError := interactor.GetByID(ctx, auth, restaurantID)
+	if getError != nil {
+		return getError
+	}
+
+	// check if user have access to update the restaurant schedule
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite, Resource(restaurantObj)).Allowed {
+
+		// check if exception belongs to the restaurant
+		_, found := findScheduleException(restaurantObj, exceptionID)
+		if !found {
+			return errors.NewAppError("Unable to find schedule exception", http.StatusNotFound, nil)
+		}
+
+		deleteError := interactor.restaurantRepository.DeleteScheduleException(ctx, restaurantID, exceptionID)
+		return deleteError
+	}
+	return errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
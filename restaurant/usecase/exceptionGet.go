@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *restaurantInteractor) GetScheduleExceptions(ctx context.Context, auth authentication.Auth,
+	restaurantID string) ([]restaurant.ScheduleException, errors.AppError) {
+
+	// user should have permission to get the restaurant
+	restaurantObj, getError := interactor.GetByID(ctx, auth, restaurantID)
+	if getError != nil {
+		return nil, getError
+	}
+
+	if restaurantObj.ScheduleExceptions == nil {
+		return []restaurant.ScheduleException{}, nil
+	}
+	return restaurantObj.ScheduleExceptions, nil
+}
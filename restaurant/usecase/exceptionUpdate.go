@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *restaurantInteractor) UpdateScheduleException(ctx context.Context, auth authentication.Auth,
+	restaurantID string, exceptionID string,
+	exception restaurant.ScheduleException) (restaurant.ScheduleException, errors.AppError) {
+
+	validationError := exception.Validate(interactor.validator)
+	if validationError != nil {
+		return restaurant.ScheduleException{}, validationError
+	}
+
+	restaurantObj, getError := interactor.GetByID(ctx, auth, restaurantID)
+	if getError != nil {
+		return restaurant.ScheduleException{}, getError
+	}
+
+	// check if user have access to update the restaurant schedule
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite, Resource(restaurantObj)).Allowed {
+
+		// check if exception belongs to the restaurant
+		existingException, found := findScheduleException(restaurantObj, exceptionID)
+		if !found {
+			return restaurant.ScheduleException{}, errors.NewAppError("Unable to find schedule exception",
+				http.StatusNotFound, nil)
+		}
+
+		exception.ID = existingException.ID
+		exception.CreatedAt = existingException.CreatedAt
+
+		var repositoryError errors.AppError
+		exception, repositoryError = interactor.restaurantRepository.UpdateScheduleException(ctx,
+			restaurantID, exception)
+		return exception, repositoryError
+	}
+	return restaurant.ScheduleException{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
+
+func findScheduleException(restaurantObj restaurant.Restaurant,
+	exceptionID string) (restaurant.ScheduleException, bool) {
+
+	for _, exception := range restaurantObj.ScheduleExceptions {
+		if exception.ID == exceptionID {
+			return exception, true
+		}
+	}
+	return restaurant.ScheduleException{}, false
+}
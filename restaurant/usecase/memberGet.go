@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *restaurantInteractor) GetMembers(ctx context.Context, auth authentication.Auth,
+	restaurantID string) ([]restaurant.Member, errors.AppError) {
+
+	restaurantObj, getError := interactor.GetByID(ctx, auth, restaurantID)
+	if getError != nil {
+		return nil, getError
+	}
+
+	// members are only visible to the restaurant staff and operators
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite, Resource(restaurantObj)).Allowed {
+		if restaurantObj.Members == nil {
+			return []restaurant.Member{}, nil
+		}
+		return restaurantObj.Members, nil
+	}
+	return nil, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *restaurantInteractor) InviteMember(ctx context.Context, auth authentication.Auth,
+	restaurantID string, member restaurant.Member) (restaurant.Member, errors.AppError) {
+
+	validationError := member.Validate(interactor.validator)
+	if validationError != nil {
+		return restaurant.Member{}, validationError
+	}
+
+	restaurantObj, getError := interactor.GetByID(ctx, auth, restaurantID)
+	if getError != nil {
+		return restaurant.Member{}, getError
+	}
+
+	// check if user have access to manage the restaurant members
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionManage, Resource(restaurantObj)).Allowed {
+
+		if member.UserID == restaurantObj.MerchantID {
+			return restaurant.Member{}, errors.NewAppError("Merchant is already the owner of the restaurant",
+				http.StatusBadRequest, nil)
+		}
+
+		member.InvitedBy = auth.GetUserID()
+		member.CreatedAt = time.Now()
+		repositoryError := interactor.restaurantRepository.AddMember(ctx, restaurantID, member)
+		if repositoryError != nil {
+			return restaurant.Member{}, repositoryError
+		}
+		return member, nil
+	}
+	return restaurant.Member{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
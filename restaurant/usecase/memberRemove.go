@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *restaurantInteractor) RemoveMember(ctx context.Context, auth authentication.Auth,
+	restaurantID string, userID string) errors.AppError {
+
+	restaurantObj, getError := interactor.GetByID(ctx, auth, restaurantID)
+	if getError != nil {
+		return getError
+	}
+
+	// check if user have access to manage the restaurant members
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionManage, Resource(restaurantObj)).Allowed {
+
+		// check if user is member of the restaurant
+		found := false
+		for _, member := range restaurantObj.Members {
+			if member.UserID == userID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.NewAppError("Unable to find member", http.StatusNotFound, nil)
+		}
+
+		deleteError := interactor.restaurantRepository.DeleteMember(ctx, restaurantID, userID)
+		return deleteError
+	}
+	return errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/category"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *restaurantInteractor) GetMenu(ctx context.Context, auth authentication.Auth,
+	restaurantID string) (Menu, errors.AppError) {
+
+	// user should have permission to get the restaurant
+	restaurantObj, getError := interactor.GetByID(ctx, auth, restaurantID)
+	if getError != nil {
+		return Menu{}, getError
+	}
+
+	categories, repositoryError := interactor.categoryRespository.GetMenuByRestaurantID(ctx, restaurantID)
+	if repositoryError != nil {
+		return Menu{}, repositoryError
+	}
+
+	return Menu{
+		Restaurant: restaurantObj,
+		Categories: category.BuildTree(categories),
+	}, nil
+}
+
+// Menu provides the schema definition for restaurant menu, sub categories are
+// nested under their parent category
+type Menu struct {
+	Restaurant restaurant.Restaurant `json:"restaurant"`
+	Categories []category.Category   `json:"categories"`
+}
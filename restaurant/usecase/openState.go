@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+)
+
+// scheduleLookAhead is the number of days for which opening hours are expanded
+// while finding the next opening time
+const scheduleLookAhead = 31
+
+type openInterval struct {
+	opens  time.Time
+	closes time.Time
+}
+
+// setOpenState computes and sets the open state of the restaurant at the provided time
+func setOpenState(restaurantObj *restaurant.Restaurant, now time.Time) {
+	restaurantObj.IsOpen = false
+	restaurantObj.NextOpensAt = nil
+	restaurantObj.ClosesAt = nil
+
+	location, locationError := time.LoadLocation(restaurantObj.TimeZone)
+	if locationError != nil {
+		location = time.UTC
+	}
+	now = now.In(location)
+	intervals := getOpenIntervals(restaurantObj.OpeningHours, restaurantObj.ScheduleExceptions, now)
+
+	// manual override wins over the opening hours till it expires
+	override := restaurantObj.OpenOverride
+	if override != nil && now.Before(override.Until) {
+		restaurantObj.IsOpen = override.IsOpen
+		if override.IsOpen {
+			closesAt := override.Until.In(location)
+			restaurantObj.ClosesAt = &closesAt
+		} else {
+			restaurantObj.NextOpensAt = getNextOpening(intervals, override.Until.In(location))
+		}
+		return
+	}
+
+	for _, interval := range intervals {
+		if !now.Before(interval.opens) && now.Before(interval.closes) {
+			closesAt := interval.closes
+			restaurantObj.IsOpen = true
+			restaurantObj.ClosesAt = &closesAt
+			return
+		}
+	}
+	restaurantObj.NextOpensAt = getNextOpening(intervals, now)
+}
+
+// getNextOpening returns the first time at or after from when restaurant is open
+func getNextOpening(intervals []openInterval, from time.Time) *time.Time {
+	for _, interval := range intervals {
+		if from.Before(interval.closes) {
+			opensAt := interval.opens
+			if opensAt.Before(from) {
+				opensAt = from
+			}
+			return &opensAt
+		}
+	}
+	return nil
+}
+
+// getOpenIntervals expands the weekly opening hours and schedule exceptions around the
+// provided time and returns sorted and merged intervals in which restaurant is open
+func getOpenIntervals(openingHours []restaurant.TimeSlot, exceptions []restaurant.ScheduleException,
+	now time.Time) []openInterval {
+
+	intervals := []openInterval{}
+
+	// start from the previous day to consider the slots crossing the midnight
+	for day := -1; day <= scheduleLookAhead; day++ {
+		date := time.Date(now.Year(), now.Month(), now.Day()+day, 0, 0, 0, 0, now.Location())
+		for _, hours := range getHoursOfDate(openingHours, exceptions, date) {
+			opens, opensError := restaurant.ParseClock(hours.Opens)
+			closes, closesError := restaurant.ParseClock(hours.Closes)
+			if opensError != nil || closesError != nil {
+				continue
+			}
+
+			closesDay := 0
+			if closes <= opens {
+				closesDay = 1
+			}
+			intervals = append(intervals, openInterval{
+				opens:  atClock(date, 0, opens),
+				closes: atClock(date, closesDay, closes),
+			})
+		}
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].opens.Before(intervals[j].opens)
+	})
+
+	// merge overlapping and adjacent intervals
+	merged := []openInterval{}
+	for _, interval := range intervals {
+		last := len(merged) - 1
+		if last >= 0 && !interval.opens.After(merged[last].closes) {
+			if interval.closes.After(merged[last].closes) {
+				merged[last].closes = interval.closes
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return merged
+}
+
+// atClock returns the wall clock time of the day which is days after the date. Clock is not added
+// to the midnight as days with daylight saving transition are shorter or longer than 24 hours
+func atClock(date time.Time, days int, clock time.Duration) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day()+days, int(clock/time.Hour),
+		int(clock%time.Hour/time.Minute), 0, 0, date.Location())
+}
+
+// getHoursOfDate returns the opening hours of the date, schedule exception covering
+// the date replaces the regular weekly opening hours
+func getHoursOfDate(openingHours []restaurant.TimeSlot, exceptions []restaurant.ScheduleException,
+	date time.Time) []restaurant.Hours {
+
+	formattedDate := date.Format(restaurant.DateFormat)
+	for _, exception := range exceptions {
+		if exception.Covers(formattedDate) {
+			return exception.SpecialHours
+		}
+	}
+
+	hours := []restaurant.Hours{}
+	for _, slot := range openingHours {
+		if slot.Weekday() == date.Weekday() {
+			hours = append(hours, restaurant.Hours{Opens: slot.Opens, Closes: slot.Closes})
+		}
+	}
+	return hours
+}
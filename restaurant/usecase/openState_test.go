@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+)
+
+func TestGetOpenIntervals(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		openingHours []restaurant.TimeSlot
+		now          time.Time
+		opens        time.Time
+		closes       time.Time
+	}{
+		{
+			name:         "regular day",
+			openingHours: []restaurant.TimeSlot{{Day: "friday", Opens: "09:00", Closes: "17:00"}},
+			now:          time.Date(2019, time.March, 8, 6, 0, 0, 0, newYork),
+			opens:        time.Date(2019, time.March, 8, 9, 0, 0, 0, newYork),
+			closes:       time.Date(2019, time.March, 8, 17, 0, 0, 0, newYork),
+		},
+		{
+			name:         "daylight saving starts",
+			openingHours: []restaurant.TimeSlot{{Day: "sunday", Opens: "09:00", Closes: "17:00"}},
+			now:          time.Date(2019, time.March, 10, 6, 0, 0, 0, newYork),
+			opens:        time.Date(2019, time.March, 10, 9, 0, 0, 0, newYork),
+			closes:       time.Date(2019, time.March, 10, 17, 0, 0, 0, newYork),
+		},
+		{
+			name:         "daylight saving ends",
+			openingHours: []restaurant.TimeSlot{{Day: "sunday", Opens: "09:00", Closes: "17:00"}},
+			now:          time.Date(2019, time.November, 3, 6, 0, 0, 0, newYork),
+			opens:        time.Date(2019, time.November, 3, 9, 0, 0, 0, newYork),
+			closes:       time.Date(2019, time.November, 3, 17, 0, 0, 0, newYork),
+		},
+		{
+			name:         "overnight slot",
+			openingHours: []restaurant.TimeSlot{{Day: "friday", Opens: "20:00", Closes: "02:00"}},
+			now:          time.Date(2019, time.March, 8, 6, 0, 0, 0, newYork),
+			opens:        time.Date(2019, time.March, 8, 20, 0, 0, 0, newYork),
+			closes:       time.Date(2019, time.March, 9, 2, 0, 0, 0, newYork),
+		},
+		{
+			name:         "overnight slot into daylight saving",
+			openingHours: []restaurant.TimeSlot{{Day: "saturday", Opens: "20:00", Closes: "04:00"}},
+			now:          time.Date(2019, time.March, 9, 6, 0, 0, 0, newYork),
+			opens:        time.Date(2019, time.March, 9, 20, 0, 0, 0, newYork),
+			closes:       time.Date(2019, time.March, 10, 4, 0, 0, 0, newYork),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			intervals := getOpenIntervals(test.openingHours, nil, test.now)
+			if len(intervals) == 0 {
+				t.Fatal("expected open intervals")
+			}
+
+			// first interval at or after now is the one of the day under test
+			for _, interval := range intervals {
+				if interval.closes.Before(test.now) {
+					continue
+				}
+				if !interval.opens.Equal(test.opens) || !interval.closes.Equal(test.closes) {
+					t.Errorf("expected %v - %v, got %v - %v", test.opens, test.closes,
+						interval.opens, interval.closes)
+				}
+				return
+			}
+			t.Error("expected an interval after now")
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+)
+
+// memberActions provides the actions delegated to the restaurant members by their role
+var memberActions = map[restaurant.MemberRole][]acl.Action{
+	restaurant.MemberRoleOwner: {acl.ActionRead, acl.ActionWrite, acl.ActionUpdateStock,
+		acl.ActionAdjustStock, acl.ActionManage},
+	restaurant.MemberRoleManager: {acl.ActionRead, acl.ActionWrite, acl.ActionUpdateStock,
+		acl.ActionAdjustStock},
+	restaurant.MemberRoleStockOnly: {acl.ActionRead, acl.ActionUpdateStock, acl.ActionAdjustStock},
+}
+
+// Resource returns the ACL resource of the restaurant along with the actions
+// delegated to its members. Catalog of the restaurant shares the same resource
+func Resource(restaurantObj restaurant.Restaurant) acl.Resource {
+	delegates := make(map[string][]acl.Action, len(restaurantObj.Members))
+	for _, member := range restaurantObj.Members {
+		delegates[member.UserID] = memberActions[member.Role]
+	}
+	return acl.Resource{
+		OwnerID:   restaurantObj.MerchantID,
+		Delegates: delegates,
+	}
+}
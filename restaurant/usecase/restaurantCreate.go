@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
 	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
@@ -18,9 +19,23 @@ func (interactor *restaurantInteractor) Create(ctx context.Context, auth authent
 		return restaurant.Restaurant{}, validationError
 	}
 
-	if (auth.GetUserID() == restaurantObj.MerchantID &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteOwn)) ||
-		(interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteAny)) {
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite, Resource(restaurantObj)).Allowed {
+
+		// opening hours of the restaurant without time zone are in UTC
+		if restaurantObj.TimeZone == "" {
+			restaurantObj.TimeZone = "UTC"
+		}
+
+		// new restaurant is not visible to the customers till it is approved
+		restaurantObj.State = restaurant.StateDraft
+		restaurantObj.StateHistory = []restaurant.StateTransition{
+			{
+				To:        restaurant.StateDraft,
+				ActorID:   auth.GetUserID(),
+				ActorRole: auth.GetUserRole(),
+				CreatedAt: time.Now(),
+			},
+		}
 
 		var repositoryError errors.AppError
 		restaurantObj, repositoryError = interactor.restaurantRepository.Create(ctx, restaurantObj)
@@ -18,30 +18,32 @@ func (interactor *restaurantInteractor) DeleteByID(ctx context.Context, auth aut
 	}
 
 	// check if user have access to delete the restaurant
-	if (auth.GetUserID() == restaurantObj.MerchantID &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteOwn)) ||
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogWriteAny) {
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionManage, Resource(restaurantObj)).Allowed {
 
-		// only closed restaurant can be deleted
+		// only closed restaurant can be deleted, open state is computed from the
+		// opening hours, schedule exceptions and open override
 		if restaurantObj.IsOpen {
 			return errors.NewAppError("Restaurant in open state cannot be deleted", http.StatusBadRequest, nil)
 		}
 
-		// delete products of the provided restaurant
-		deleteProductError := interactor.productRepository.DeleteProductByRestaurantID(ctx, restaurantID)
-		if deleteProductError != nil {
-			return deleteProductError
-		}
-
-		// delete categories of the provided restaurant
-		deleteCategoryError := interactor.categoryRespository.DeleteByRestaurantID(ctx, restaurantID)
-		if deleteCategoryError != nil {
-			return deleteCategoryError
-		}
-
-		// finially delete the restaurant
-		deleteError := interactor.restaurantRepository.DeleteByID(ctx, restaurantID)
-		return deleteError
+		// restaurant is deleted along with its catalog or not at all
+		return interactor.unitOfWork.Do(ctx, func(ctx context.Context) errors.AppError {
+			// delete products of the provided restaurant
+			deleteProductError := interactor.productRepository.DeleteProductByRestaurantID(ctx, restaurantID)
+			if deleteProductError != nil {
+				return deleteProductError
+			}
+
+			// delete categories of the provided restaurant
+			deleteCategoryError := interactor.categoryRespository.DeleteByRestaurantID(ctx, restaurantID)
+			if deleteCategoryError != nil {
+				return deleteCategoryError
+			}
+
+			// finially delete the restaurant
+			deleteError := interactor.restaurantRepository.DeleteByID(ctx, restaurantID)
+			return deleteError
+		})
 	}
 	return errors.NewAppError("Forbidden", http.StatusForbidden, nil)
 }
@@ -6,6 +6,7 @@ import (
 	"math"
 	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
 	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
@@ -27,23 +28,71 @@ func (interactor *restaurantInteractor) GetByID(ctx context.Context, auth authen
 		return restaurant.Restaurant{}, errors.NewAppError("Unable to find restaurant", http.StatusNotFound, nil)
 	}
 
-	if (auth.GetUserID() == restaurantObj.MerchantID &&
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogReadOwn)) ||
-		interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogReadAny) {
-		return restaurantObj, nil
+	readError := interactor.authorizeRead(ctx, auth, &restaurantObj)
+	if readError != nil {
+		return restaurant.Restaurant{}, readError
 	}
 
-	return restaurant.Restaurant{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+	setOpenState(&restaurantObj, time.Now())
+	return restaurantObj, nil
+}
+
+func (interactor *restaurantInteractor) GetByIDs(ctx context.Context, auth authentication.Auth,
+	restaurantIDs []string) ([]restaurant.Restaurant, errors.AppError) {
+
+	restaurants, repositoryError := interactor.restaurantRepository.GetByIDs(ctx, restaurantIDs)
+	if repositoryError != nil {
+		return nil, repositoryError
+	}
+
+	// restaurants which the user can't read are left out
+	now := time.Now()
+	visibleRestaurants := []restaurant.Restaurant{}
+	for _, restaurantObj := range restaurants {
+		if interactor.authorizeRead(ctx, auth, &restaurantObj) == nil {
+			setOpenState(&restaurantObj, now)
+			visibleRestaurants = append(visibleRestaurants, restaurantObj)
+		}
+	}
+	return visibleRestaurants, nil
+}
+
+// authorizeRead checks if the user can read the restaurant, restaurant which is not live
+// is only visible to its merchant, staff and operators
+func (interactor *restaurantInteractor) authorizeRead(ctx context.Context, auth authentication.Auth,
+	restaurantObj *restaurant.Restaurant) errors.AppError {
+
+	// restaurants created before the lifecycle states were introduced are live
+	if restaurantObj.State == "" {
+		restaurantObj.State = restaurant.StateLive
+	}
+
+	resource := Resource(*restaurantObj)
+	decision := interactor.authorizer.Authorize(ctx, auth, acl.ActionRead, resource)
+	if !decision.Allowed {
+		return errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+	}
+
+	isStaff := decision.Reason == acl.ReasonOwner || decision.Reason == acl.ReasonDelegate
+	if restaurantObj.State != restaurant.StateLive && !isStaff &&
+		!interactor.authorizer.Can(auth, acl.ActionModerate, resource).Allowed {
+		return errors.NewAppError("Unable to find restaurant", http.StatusNotFound, nil)
+	}
+	return nil
 }
 
 func (interactor *restaurantInteractor) GetAllRestaurants(ctx context.Context, auth authentication.Auth,
 	request GetAllRestaurantsRequest) (GetAllRestaurantsResponse, errors.AppError) {
 
-	var restaurants []restaurant.Restaurant
-	var totalCount, maxDistance int64
+	var restaurants []NearbyRestaurant
+	var totalCount int64
 	var restaurantResponse GetAllRestaurantsResponse
 
-	maxDistance = 10000
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return restaurantResponse, validationError
+	}
+
 	async, asyncCtx := async.WithContext(ctx)
 
 	if request.PageNumber == 0 {
@@ -52,22 +101,35 @@ func (interactor *restaurantInteractor) GetAllRestaurants(ctx context.Context, a
 	if request.PageSize == 0 {
 		request.PageSize = 50
 	}
+	// customers can only find the live restaurants
+	request.State = restaurant.StateLive
+	if request.Radius == 0 {
+		request.Radius = interactor.maxSearchRadius
+	}
+
+	// search radius is capped by the server
+	if request.Radius > interactor.maxSearchRadius {
+		return restaurantResponse, errors.NewAppError(
+			fmt.Sprintf("radius cannot be greater than %v meters", interactor.maxSearchRadius),
+			http.StatusBadRequest, nil)
+	}
 
 	GetAllRestaurants := func() errors.AppError {
 		var repositoryError errors.AppError
 		restaurants, repositoryError = interactor.restaurantRepository.GetAllRestaurants(asyncCtx,
-			request, maxDistance)
+			request)
 		return repositoryError
 	}
 
 	getTotalCount := func() errors.AppError {
 		var repositoryError errors.AppError
 		totalCount, repositoryError = interactor.restaurantRepository.GetAllRestaurantsTotalCount(asyncCtx,
-			request, maxDistance)
+			request)
 		return repositoryError
 	}
 
-	if interactor.rbac.Can(auth.GetUserRole(), acl.PermissionCatalogReadAny) {
+	// nearby restaurants are not owned by a single merchant
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionRead, acl.Resource{}).Allowed {
 
 		async.Go(GetAllRestaurants)
 		async.Go(getTotalCount)
@@ -76,6 +138,12 @@ func (interactor *restaurantInteractor) GetAllRestaurants(ctx context.Context, a
 			return restaurantResponse, err
 		}
 
+		now := time.Now()
+		for i := range restaurants {
+			restaurants[i].State = restaurant.StateLive
+			setOpenState(&restaurants[i].Restaurant, now)
+		}
+
 		restaurantResponse = GetAllRestaurantsResponse{
 			Total:       totalCount,
 			PageNumber:  request.PageNumber,
@@ -94,6 +162,9 @@ type GetAllRestaurantsRequest struct {
 	PageSize   int64   `schema:"pageSize" json:"pageSize" validate:"lte=100"`
 	Latitude   float64 `schema:"latitude" json:"latitude" validate:"required,latitude"`
 	Longitude  float64 `schema:"longitude" json:"longitude" validate:"required,longitude"`
+	Radius     float64 `schema:"radius" json:"radius" validate:"gte=0"`
+	// State is set by the service, restaurants in other states are filtered out
+	State restaurant.State `schema:"-" json:"-"`
 }
 
 // Validate validates GetAllRestaurantsRequest
@@ -112,9 +183,16 @@ func (request GetAllRestaurantsRequest) Validate(validate *validator.Validate) e
 
 // GetAllRestaurantsResponse provides the schema definition for get all restaurant response
 type GetAllRestaurantsResponse struct {
-	Total       int64                   `json:"total"`
-	PageNumber  int64                   `json:"page_number"`
-	PageSize    int64                   `json:"page_size"`
-	TotalPages  int64                   `json:"total_pages"`
-	Restaurants []restaurant.Restaurant `json:"restaurants"`
+	Total       int64              `json:"total"`
+	PageNumber  int64              `json:"page_number"`
+	PageSize    int64              `json:"page_size"`
+	TotalPages  int64              `json:"total_pages"`
+	Restaurants []NearbyRestaurant `json:"restaurants"`
+}
+
+// NearbyRestaurant provides the schema definition for restaurant along with
+// its distance(in meters) from the searched location
+type NearbyRestaurant struct {
+	restaurant.Restaurant `bson:",inline"`
+	DistanceMeters        float64 `bson:"distance_meters" json:"distance_meters"`
 }
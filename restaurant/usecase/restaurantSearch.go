@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/async"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+func (interactor *restaurantInteractor) SearchRestaurants(ctx context.Context, auth authentication.Auth,
+	request SearchRestaurantsRequest) (SearchRestaurantsResponse, errors.AppError) {
+
+	var restaurants []restaurant.Restaurant
+	var totalCount int64
+	var restaurantResponse SearchRestaurantsResponse
+
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return restaurantResponse, validationError
+	}
+
+	async, asyncCtx := async.WithContext(ctx)
+
+	if request.PageNumber == 0 {
+		request.PageNumber = 1
+	}
+	if request.PageSize == 0 {
+		request.PageSize = 50
+	}
+
+	searchRestaurants := func() errors.AppError {
+		var repositoryError errors.AppError
+		restaurants, repositoryError = interactor.restaurantRepository.SearchRestaurants(asyncCtx,
+			request)
+		return repositoryError
+	}
+
+	getTotalCount := func() errors.AppError {
+		var repositoryError errors.AppError
+		totalCount, repositoryError = interactor.restaurantRepository.SearchRestaurantsTotalCount(asyncCtx,
+			request)
+		return repositoryError
+	}
+
+	// only operators are allowed to search restaurants of every merchant
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionModerate, acl.Resource{}).Allowed {
+
+		async.Go(searchRestaurants)
+		async.Go(getTotalCount)
+		err := async.Wait()
+		if err != nil {
+			return restaurantResponse, err
+		}
+
+		for i := range restaurants {
+			// restaurants created before the lifecycle states were introduced are live
+			if restaurants[i].State == "" {
+				restaurants[i].State = restaurant.StateLive
+			}
+		}
+
+		restaurantResponse = SearchRestaurantsResponse{
+			Total:       totalCount,
+			PageNumber:  request.PageNumber,
+			PageSize:    request.PageSize,
+			TotalPages:  int64(math.Ceil(float64(totalCount) / float64(request.PageSize))),
+			Restaurants: restaurants,
+		}
+		return restaurantResponse, nil
+	}
+	return restaurantResponse, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
+
+// SearchRestaurantsRequest provides the schema definition for search restaurant request
+type SearchRestaurantsRequest struct {
+	PageNumber int64            `schema:"pageNumber" json:"pageNumber" validate:"gte=0"`
+	PageSize   int64            `schema:"pageSize" json:"pageSize" validate:"lte=100"`
+	MerchantID string           `schema:"merchantId" json:"merchantId"`
+	State      restaurant.State `schema:"state" json:"state" validate:"omitempty,oneof=draft pending_approval live paused suspended archived"`
+	Name       string           `schema:"name" json:"name" validate:"max=30"`
+	City       string           `schema:"city" json:"city"`
+}
+
+// Validate validates SearchRestaurantsRequest
+func (request SearchRestaurantsRequest) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("validation for field '%s' failed on '%s'", err.Field(), err.Tag())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+// SearchRestaurantsResponse provides the schema definition for search restaurant response
+type SearchRestaurantsResponse struct {
+	Total       int64                   `json:"total"`
+	PageNumber  int64                   `json:"page_number"`
+	PageSize    int64                   `json:"page_size"`
+	TotalPages  int64                   `json:"total_pages"`
+	Restaurants []restaurant.Restaurant `json:"restaurants"`
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// transitionRule provides the rule for moving restaurant from one state to another
+type transitionRule struct {
+	// operatorOnly transitions can't be performed by the restaurant merchant
+	operatorOnly bool
+}
+
+// lifecycle provides the legal transitions of the restaurant lifecycle
+var lifecycle = map[restaurant.State]map[restaurant.State]transitionRule{
+	restaurant.StateDraft: {
+		restaurant.StatePendingApproval: {},
+		restaurant.StateArchived:        {},
+	},
+	restaurant.StatePendingApproval: {
+		restaurant.StateDraft:     {},
+		restaurant.StateLive:      {operatorOnly: true},
+		restaurant.StateSuspended: {operatorOnly: true},
+	},
+	restaurant.StateLive: {
+		restaurant.StatePaused:    {},
+		restaurant.StateSuspended: {operatorOnly: true},
+		restaurant.StateArchived:  {},
+	},
+	restaurant.StatePaused: {
+		restaurant.StateLive:      {},
+		restaurant.StateSuspended: {operatorOnly: true},
+		restaurant.StateArchived:  {},
+	},
+	restaurant.StateSuspended: {
+		restaurant.StateLive:     {operatorOnly: true},
+		restaurant.StateArchived: {operatorOnly: true},
+	},
+	restaurant.StateArchived: {},
+}
+
+func (interactor *restaurantInteractor) Transition(ctx context.Context, auth authentication.Auth,
+	restaurantID string, request TransitionRequest) (restaurant.Restaurant, errors.AppError) {
+
+	validationError := request.Validate(interactor.validator)
+	if validationError != nil {
+		return restaurant.Restaurant{}, validationError
+	}
+
+	restaurantObj, getError := interactor.GetByID(ctx, auth, restaurantID)
+	if getError != nil {
+		return restaurant.Restaurant{}, getError
+	}
+
+	decision := interactor.authorizer.Authorize(ctx, auth, acl.ActionManage, Resource(restaurantObj))
+	if !decision.Allowed {
+		return restaurant.Restaurant{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+	}
+
+	rule, legal := lifecycle[restaurantObj.State][request.State]
+	if !legal {
+		return restaurant.Restaurant{}, errors.NewAppError(
+			fmt.Sprintf("Restaurant cannot be moved from '%s' to '%s' state", restaurantObj.State, request.State),
+			http.StatusBadRequest, nil)
+	}
+
+	if rule.operatorOnly && decision.Reason != acl.ReasonAnyPermission {
+		return restaurant.Restaurant{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+	}
+
+	transition := restaurant.StateTransition{
+		From:      restaurantObj.State,
+		To:        request.State,
+		ActorID:   auth.GetUserID(),
+		ActorRole: auth.GetUserRole(),
+		Reason:    request.Reason,
+		CreatedAt: time.Now(),
+	}
+	repositoryError := interactor.restaurantRepository.UpdateState(ctx, restaurantID, transition)
+	if repositoryError != nil {
+		return restaurant.Restaurant{}, repositoryError
+	}
+
+	restaurantObj.State = transition.To
+	restaurantObj.StateHistory = append(restaurantObj.StateHistory, transition)
+	restaurantObj.UpdatedAt = transition.CreatedAt
+	return restaurantObj, nil
+}
+
+// TransitionRequest provides the schema definition for restaurant state transition request
+type TransitionRequest struct {
+	State  restaurant.State `json:"state" validate:"required"`
+	Reason string           `json:"reason" validate:"max=120"`
+}
+
+// Validate validates TransitionRequest
+func (request TransitionRequest) Validate(validate *validator.Validate) errors.AppError {
+	var errMessage string
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			errMessage = fmt.Sprintf("validation for field '%s' failed on '%s'", err.Field(), err.Tag())
+			break
+		}
+		return errors.NewAppError(errMessage, http.StatusBadRequest, err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/mergepatch"
+	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
+	"github.com/dhyaniarun1993/foody-common/authentication"
+	"github.com/dhyaniarun1993/foody-common/errors"
+)
+
+func (interactor *restaurantInteractor) Update(ctx context.Context, auth authentication.Auth,
+	restaurantID string, patch json.RawMessage) (restaurant.Restaurant, errors.AppError) {
+
+	restaurantObj, getError := interactor.GetByID(ctx, auth, restaurantID)
+	if getError != nil {
+		return restaurant.Restaurant{}, getError
+	}
+
+	// check if user have access to update the restaurant
+	if interactor.authorizer.Authorize(ctx, auth, acl.ActionWrite, Resource(restaurantObj)).Allowed {
+
+		updatedRestaurant := restaurantObj
+		patchError := mergepatch.Apply(&updatedRestaurant, patch)
+		if patchError != nil {
+			return restaurant.Restaurant{}, patchError
+		}
+
+		// fields managed by the service cannot be patched
+		updatedRestaurant.ID = restaurantObj.ID
+		updatedRestaurant.MerchantID = restaurantObj.MerchantID
+		updatedRestaurant.ReviewsRatingSum = restaurantObj.ReviewsRatingSum
+		updatedRestaurant.ReviewsCount = restaurantObj.ReviewsCount
+		updatedRestaurant.CreatedAt = restaurantObj.CreatedAt
+		updatedRestaurant.ScheduleExceptions = restaurantObj.ScheduleExceptions
+		updatedRestaurant.State = restaurantObj.State
+		updatedRestaurant.StateHistory = restaurantObj.StateHistory
+		updatedRestaurant.Members = restaurantObj.Members
+		if updatedRestaurant.TimeZone == "" {
+			updatedRestaurant.TimeZone = "UTC"
+		}
+
+		validationError := updatedRestaurant.Validate(interactor.validator)
+		if validationError != nil {
+			return restaurant.Restaurant{}, validationError
+		}
+
+		var repositoryError errors.AppError
+		updatedRestaurant, repositoryError = interactor.restaurantRepository.Update(ctx, updatedRestaurant)
+		return updatedRestaurant, repositoryError
+	}
+	return restaurant.Restaurant{}, errors.NewAppError("Forbidden", http.StatusForbidden, nil)
+}
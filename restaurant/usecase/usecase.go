@@ -2,10 +2,12 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 
 	"gopkg.in/go-playground/validator.v9"
 
 	"github.com/dhyaniarun1993/foody-catalog-service/acl"
+	"github.com/dhyaniarun1993/foody-catalog-service/category"
 	"github.com/dhyaniarun1993/foody-catalog-service/restaurant"
 
 	"github.com/dhyaniarun1993/foody-common/authentication"
@@ -13,18 +15,33 @@ import (
 	"github.com/dhyaniarun1993/foody-common/logger"
 )
 
+type unitOfWork interface {
+	Do(ctx context.Context, work func(ctx context.Context) errors.AppError) errors.AppError
+}
+
 type restaurantRepository interface {
 	Create(context.Context, restaurant.Restaurant) (restaurant.Restaurant, errors.AppError)
 	GetByID(context.Context, string) (restaurant.Restaurant, errors.AppError)
+	GetByIDs(context.Context, []string) ([]restaurant.Restaurant, errors.AppError)
+	Update(context.Context, restaurant.Restaurant) (restaurant.Restaurant, errors.AppError)
 	DeleteByID(context.Context, string) errors.AppError
-	GetAllRestaurants(context.Context, GetAllRestaurantsRequest,
-		int64) ([]restaurant.Restaurant, errors.AppError)
-	GetAllRestaurantsTotalCount(context.Context, GetAllRestaurantsRequest,
-		int64) (int64, errors.AppError)
+	UpdateState(context.Context, string, restaurant.StateTransition) errors.AppError
+	AddScheduleException(context.Context, string,
+		restaurant.ScheduleException) (restaurant.ScheduleException, errors.AppError)
+	UpdateScheduleException(context.Context, string,
+		restaurant.ScheduleException) (restaurant.ScheduleException, errors.AppError)
+	DeleteScheduleException(context.Context, string, string) errors.AppError
+	AddMember(context.Context, string, restaurant.Member) errors.AppError
+	DeleteMember(context.Context, string, string) errors.AppError
+	GetAllRestaurants(context.Context, GetAllRestaurantsRequest) ([]NearbyRestaurant, errors.AppError)
+	GetAllRestaurantsTotalCount(context.Context, GetAllRestaurantsRequest) (int64, errors.AppError)
+	SearchRestaurants(context.Context, SearchRestaurantsRequest) ([]restaurant.Restaurant, errors.AppError)
+	SearchRestaurantsTotalCount(context.Context, SearchRestaurantsRequest) (int64, errors.AppError)
 }
 
 type categoryRespository interface {
 	DeleteByRestaurantID(context.Context, string) errors.AppError
+	GetMenuByRestaurantID(context.Context, string) ([]category.Category, errors.AppError)
 }
 
 type productRepository interface {
@@ -37,30 +54,56 @@ type Interactor interface {
 		restaurant restaurant.Restaurant) (restaurant.Restaurant, errors.AppError)
 	GetByID(ctx context.Context, auth authentication.Auth,
 		restaurantID string) (restaurant.Restaurant, errors.AppError)
+	GetByIDs(ctx context.Context, auth authentication.Auth,
+		restaurantIDs []string) ([]restaurant.Restaurant, errors.AppError)
+	Update(ctx context.Context, auth authentication.Auth, restaurantID string,
+		patch json.RawMessage) (restaurant.Restaurant, errors.AppError)
 	DeleteByID(ctx context.Context, auth authentication.Auth, restaurantID string) errors.AppError
+	Transition(ctx context.Context, auth authentication.Auth, restaurantID string,
+		request TransitionRequest) (restaurant.Restaurant, errors.AppError)
 	GetAllRestaurants(ctx context.Context, auth authentication.Auth,
 		request GetAllRestaurantsRequest) (GetAllRestaurantsResponse, errors.AppError)
+	SearchRestaurants(ctx context.Context, auth authentication.Auth,
+		request SearchRestaurantsRequest) (SearchRestaurantsResponse, errors.AppError)
+	GetMenu(ctx context.Context, auth authentication.Auth, restaurantID string) (Menu, errors.AppError)
+	CreateScheduleException(ctx context.Context, auth authentication.Auth, restaurantID string,
+		exception restaurant.ScheduleException) (restaurant.ScheduleException, errors.AppError)
+	GetScheduleExceptions(ctx context.Context, auth authentication.Auth,
+		restaurantID string) ([]restaurant.ScheduleException, errors.AppError)
+	UpdateScheduleException(ctx context.Context, auth authentication.Auth, restaurantID string,
+		exceptionID string, exception restaurant.ScheduleException) (restaurant.ScheduleException, errors.AppError)
+	DeleteScheduleException(ctx context.Context, auth authentication.Auth, restaurantID string,
+		exceptionID string) errors.AppError
+	InviteMember(ctx context.Context, auth authentication.Auth, restaurantID string,
+		member restaurant.Member) (restaurant.Member, errors.AppError)
+	GetMembers(ctx context.Context, auth authentication.Auth,
+		restaurantID string) ([]restaurant.Member, errors.AppError)
+	RemoveMember(ctx context.Context, auth authentication.Auth, restaurantID string, userID string) errors.AppError
 }
 
 type restaurantInteractor struct {
 	restaurantRepository restaurantRepository
 	categoryRespository  categoryRespository
 	productRepository    productRepository
+	unitOfWork           unitOfWork
 	logger               *logger.Logger
-	rbac                 acl.RBAC
+	authorizer           acl.Authorizer
 	validator            *validator.Validate
+	maxSearchRadius      float64
 }
 
 // NewRestaurantInteractor creates and return restaurant Interactor
 func NewRestaurantInteractor(restaurantRepository restaurantRepository, categoryRespository categoryRespository,
-	productRepository productRepository, logger *logger.Logger, rbac acl.RBAC,
-	validator *validator.Validate) Interactor {
+	productRepository productRepository, unitOfWork unitOfWork, logger *logger.Logger,
+	authorizer acl.Authorizer, validator *validator.Validate, maxSearchRadius float64) Interactor {
 	return &restaurantInteractor{
 		restaurantRepository: restaurantRepository,
 		categoryRespository:  categoryRespository,
 		productRepository:    productRepository,
+		unitOfWork:           unitOfWork,
 		logger:               logger,
-		rbac:                 rbac,
+		authorizer:           authorizer,
 		validator:            validator,
+		maxSearchRadius:      maxSearchRadius,
 	}
 }